@@ -0,0 +1,84 @@
+package options_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestBigInt(t *testing.T) {
+	const digits = "123456789012345678901234567890"
+	n, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		t.Fatal("invalid number")
+	}
+
+	assertEqual(t, marshal(t, options.New(n)), digits)
+	assertEqual(t, marshal(t, options.None[*big.Int]()), `null`)
+
+	opt1 := unmarshal[options.Option[*big.Int]](t, digits)
+	assertEqual(t, opt1.Unwrap().Cmp(n), 0)
+	assertEqual(t, *unmarshal[options.Option[*big.Int]](t, `null`), options.None[*big.Int]())
+
+	var opt2 options.Option[*big.Int]
+	if err := opt2.Scan(digits); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2.Unwrap().Cmp(n), 0)
+
+	var opt3 options.Option[*big.Int]
+	if err := opt3.Scan([]byte(digits)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt3.Unwrap().Cmp(n), 0)
+
+	var opt4 options.Option[*big.Int]
+	if err := opt4.Scan(int64(-42)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt4.Unwrap().Cmp(big.NewInt(-42)), 0)
+
+	var opt5 options.Option[*big.Int]
+	err := opt5.Scan("foo")
+	assertEqual(t, err.Error(), `Option[*big.Int].Scan: converting driver.Value type string ("foo") to a *big.Int: math/big: cannot unmarshal "foo" into a *big.Int`)
+	assertEqual(t, opt5, options.None[*big.Int]())
+
+	var opt6 options.Option[*big.Int]
+	if err := opt6.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt6, options.None[*big.Int]())
+
+	assertEqual[any](t, toSQLValue(t, options.New(n)), digits)
+	assertEqual[any](t, toSQLValue(t, options.None[*big.Int]()), nil)
+	assertEqual[any](t, toSQLValue(t, options.New[*big.Int](nil)), nil)
+
+	var opt7 options.Option[*big.Int]
+	if err := opt7.Scan(toSQLValue(t, options.New(n))); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt7.Unwrap().Cmp(n), 0)
+}
+
+func TestBigRat(t *testing.T) {
+	r := big.NewRat(1, 3)
+
+	assertEqual(t, marshal(t, options.New(r)), `"1/3"`)
+	opt1 := unmarshal[options.Option[*big.Rat]](t, `"1/3"`)
+	assertEqual(t, opt1.Unwrap().Cmp(r), 0)
+
+	var opt2 options.Option[*big.Rat]
+	if err := opt2.Scan("0.25"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2.Unwrap().Cmp(big.NewRat(1, 4)), 0)
+
+	var opt3 options.Option[*big.Rat]
+	if err := opt3.Scan(float64(1.5)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt3.Unwrap().Cmp(big.NewRat(3, 2)), 0)
+
+	assertEqual[any](t, toSQLValue(t, options.New(r)), "1/3")
+}
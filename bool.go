@@ -0,0 +1,61 @@
+package options
+
+// The functions in this file interpret Option[bool] as a nullable boolean of SQL,
+// where None represents NULL, i.e. unknown, in three-valued logic.
+// They are functions rather than methods because methods can't be defined only for Option[bool].
+
+// IsTrue returns true if the option is present and true.
+// This is the same as "x IS TRUE" in SQL.
+func IsTrue(o Option[bool]) bool {
+	return o.present && o.value
+}
+
+// IsFalse returns true if the option is present and false.
+// This is the same as "x IS FALSE" in SQL.
+func IsFalse(o Option[bool]) bool {
+	return o.present && !o.value
+}
+
+// BoolAnd returns the logical conjunction of the options in three-valued logic.
+//
+//	a \ b   true    false   None
+//	true    true    false   None
+//	false   false   false   false
+//	None    None    false   None
+func BoolAnd(a, b Option[bool]) Option[bool] {
+	switch {
+	case IsFalse(a) || IsFalse(b):
+		return New(false)
+	case a.present && b.present:
+		return New(true)
+	default:
+		return None[bool]()
+	}
+}
+
+// BoolOr returns the logical disjunction of the options in three-valued logic.
+//
+//	a \ b   true    false   None
+//	true    true    true    true
+//	false   true    false   None
+//	None    true    None    None
+func BoolOr(a, b Option[bool]) Option[bool] {
+	switch {
+	case IsTrue(a) || IsTrue(b):
+		return New(true)
+	case a.present && b.present:
+		return New(false)
+	default:
+		return None[bool]()
+	}
+}
+
+// BoolNot returns the logical negation of the option in three-valued logic.
+// If the option is None, None is returned.
+func BoolNot(o Option[bool]) Option[bool] {
+	if o.present {
+		return New(!o.value)
+	} else {
+		return None[bool]()
+	}
+}
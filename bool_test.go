@@ -0,0 +1,51 @@
+package options_test
+
+import (
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestIsTrueIsFalse(t *testing.T) {
+	assertEqual(t, options.IsTrue(options.New(true)), true)
+	assertEqual(t, options.IsTrue(options.New(false)), false)
+	assertEqual(t, options.IsTrue(options.None[bool]()), false)
+
+	assertEqual(t, options.IsFalse(options.New(true)), false)
+	assertEqual(t, options.IsFalse(options.New(false)), true)
+	assertEqual(t, options.IsFalse(options.None[bool]()), false)
+}
+
+func TestThreeValuedLogic(t *testing.T) {
+	T := options.New(true)
+	F := options.New(false)
+	N := options.None[bool]()
+
+	testCases := []struct {
+		a, b options.Option[bool]
+		and  options.Option[bool]
+		or   options.Option[bool]
+	}{
+		{T, T, T, T},
+		{T, F, F, T},
+		{T, N, N, T},
+		{F, T, F, T},
+		{F, F, F, F},
+		{F, N, F, N},
+		{N, T, N, T},
+		{N, F, F, N},
+		{N, N, N, N},
+	}
+	for _, tc := range testCases {
+		if actual := options.BoolAnd(tc.a, tc.b); actual != tc.and {
+			t.Errorf("%v AND %v: expected %v, actual %v", tc.a, tc.b, tc.and, actual)
+		}
+		if actual := options.BoolOr(tc.a, tc.b); actual != tc.or {
+			t.Errorf("%v OR %v: expected %v, actual %v", tc.a, tc.b, tc.or, actual)
+		}
+	}
+
+	assertEqual(t, options.BoolNot(T), F)
+	assertEqual(t, options.BoolNot(F), T)
+	assertEqual(t, options.BoolNot(N), N)
+}
@@ -0,0 +1,31 @@
+package options
+
+// Comparable[T] is an option whose value type is constrained to be comparable.
+//
+// It documents that options of the type are intended to be compared by == operator or used as map keys.
+// It gives no extra safety over Option[T]: comparing Option[T] by == already fails to compile
+// for non-comparable T, and for an interface T, == panics at run time with either type
+// when the dynamic type of the values is not comparable.
+//
+// Comparable[T] embeds Option[T], so the methods of Option[T] are available,
+// and JSON, XML and SQL are handled in the same way as Option[T].
+type Comparable[T comparable] struct {
+	Option[T]
+}
+
+// NewComparable returns a new Comparable[T] with the given value.
+func NewComparable[T comparable](value T) Comparable[T] {
+	return Comparable[T]{Option: New(value)}
+}
+
+// ToComparable converts Option[T] into Comparable[T].
+// Use the Option field to convert it back into Option[T].
+func ToComparable[T comparable](o Option[T]) Comparable[T] {
+	return Comparable[T]{Option: o}
+}
+
+// Equal returns true if the two options are equal by [Option.Equal].
+// Note that this may differ from == operator, e.g. for [time.Time] in different locations.
+func (c Comparable[T]) Equal(other Comparable[T]) bool {
+	return c.Option.Equal(other.Option)
+}
@@ -0,0 +1,42 @@
+package options_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestComparable(t *testing.T) {
+	some := options.NewComparable(42)
+	none := options.ToComparable(options.None[int]())
+
+	assertEqual(t, some == options.ToComparable(options.New(42)), true)
+	assertEqual(t, some == none, false)
+	assertEqual(t, some.Equal(options.NewComparable(42)), true)
+	assertEqual(t, some.Equal(none), false)
+	assertEqual(t, none.Equal(options.Comparable[int]{}), true)
+
+	assertEqual(t, some.Option, options.New(42))
+	assertEqual(t, some.Option.Equal(options.New(42)), true)
+	assertEqual(t, some.IsPresent(), true)
+	assertEqual(t, none.IsNone(), true)
+
+	assertEqual(t, marshal(t, some), `42`)
+	assertEqual(t, marshal(t, none), `null`)
+	assertEqual(t, *unmarshal[options.Comparable[int]](t, `42`), some)
+	assertEqual(t, *unmarshal[options.Comparable[int]](t, `null`), none)
+
+	m := map[options.Comparable[string]]int{
+		options.NewComparable("foo"):                 1,
+		options.ToComparable(options.None[string]()): 2,
+	}
+	assertEqual(t, m[options.NewComparable("foo")], 1)
+	assertEqual(t, m[options.Comparable[string]{}], 2)
+
+	utc := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	jst := utc.In(time.FixedZone("JST", 9*60*60))
+	assertEqual(t, options.NewComparable(utc).Equal(options.NewComparable(jst)), true)
+	assertEqual(t, options.New(utc).Equal(options.New(jst)), true)
+	assertEqual(t, options.NewComparable(utc) == options.NewComparable(jst), false)
+}
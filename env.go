@@ -0,0 +1,29 @@
+package options
+
+import (
+	"fmt"
+	"os"
+)
+
+// FromEnv returns the value of the environment variable named by the key.
+// If the variable is not set, None is returned.
+// If the variable is set to an empty string, a present empty string is returned.
+func FromEnv(key string) Option[string] {
+	return FromTuple(os.LookupEnv(key))
+}
+
+// FromEnvParsed returns the value of the environment variable named by the key parsed by the given function.
+// If the variable is not set, None and a nil error are returned without calling parse.
+// Unlike [ParseInto], an empty value is passed to parse since the variable is set.
+// If parse returns an error, None and an error wrapping it are returned.
+func FromEnvParsed[T any](key string, parse func(string) (T, error)) (Option[T], error) {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return None[T](), nil
+	}
+	v, err := parse(s)
+	if err != nil {
+		return None[T](), fmt.Errorf("FromEnvParsed: %s: %w", key, err)
+	}
+	return New(v), nil
+}
@@ -0,0 +1,41 @@
+package options_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestFromEnv(t *testing.T) {
+	t.Setenv("OPTIONS_TEST_SET", "hello")
+	t.Setenv("OPTIONS_TEST_EMPTY", "")
+
+	assertEqual(t, options.FromEnv("OPTIONS_TEST_SET"), options.New("hello"))
+	assertEqual(t, options.FromEnv("OPTIONS_TEST_EMPTY"), options.New(""))
+	assertEqual(t, options.FromEnv("OPTIONS_TEST_UNSET"), options.None[string]())
+}
+
+func TestFromEnvParsed(t *testing.T) {
+	t.Setenv("OPTIONS_TEST_PORT", "8080")
+	t.Setenv("OPTIONS_TEST_EMPTY", "")
+	t.Setenv("OPTIONS_TEST_INVALID", "foo")
+
+	port, err := options.FromEnvParsed("OPTIONS_TEST_PORT", strconv.Atoi)
+	assertEqual(t, err, nil)
+	assertEqual(t, port, options.New(8080))
+
+	unset, err := options.FromEnvParsed("OPTIONS_TEST_UNSET", strconv.Atoi)
+	assertEqual(t, err, nil)
+	assertEqual(t, unset, options.None[int]())
+
+	empty, err := options.FromEnvParsed("OPTIONS_TEST_EMPTY", strconv.Atoi)
+	assertEqual(t, err.Error(), `FromEnvParsed: OPTIONS_TEST_EMPTY: strconv.Atoi: parsing "": invalid syntax`)
+	assertEqual(t, empty, options.None[int]())
+
+	invalid, err := options.FromEnvParsed("OPTIONS_TEST_INVALID", strconv.Atoi)
+	var numErr *strconv.NumError
+	assertEqual(t, errors.As(err, &numErr), true)
+	assertEqual(t, invalid, options.None[int]())
+}
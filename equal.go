@@ -0,0 +1,61 @@
+package options
+
+import (
+	"reflect"
+	"sync"
+)
+
+// plainTypes caches the result of isPlain for array and struct types.
+var plainTypes sync.Map // map[reflect.Type]bool
+
+// isPlain returns true if the values of type t can be compared by == operator
+// with the same result as reflect.DeepEqual.
+// Such types contain no pointers, interfaces, or other reference types.
+func isPlain(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Array, reflect.Struct:
+		if v, ok := plainTypes.Load(t); ok {
+			return v.(bool)
+		}
+		plain := checkPlain(t)
+		plainTypes.Store(t, plain)
+		return plain
+	default:
+		return isPlainKind(t.Kind())
+	}
+}
+
+func checkPlain(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Array:
+		return checkPlain(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !checkPlain(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	default:
+		return isPlainKind(t.Kind())
+	}
+}
+
+func isPlainKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.String:
+		return true
+	default:
+		return false
+	}
+}
+
+// isNilPointer returns true if v is a nil pointer.
+func isNilPointer(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
@@ -0,0 +1,17 @@
+package options
+
+import "errors"
+
+// ErrorIs reports whether the error of the option matches target by [errors.Is].
+// If the option is None, false is returned.
+//
+// This is a function rather than a method because methods can't be defined only for Option[error].
+func ErrorIs(o Option[error], target error) bool {
+	return o.present && errors.Is(o.value, target)
+}
+
+// ErrorAs finds the first error in the chain of the error of the option that matches target by [errors.As].
+// If the option is None, false is returned and target is not modified.
+func ErrorAs(o Option[error], target any) bool {
+	return o.present && errors.As(o.value, target)
+}
@@ -0,0 +1,32 @@
+package options_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestErrorIs(t *testing.T) {
+	wrapped := options.New(fmt.Errorf("open: %w", fs.ErrNotExist))
+	assertEqual(t, options.ErrorIs(wrapped, fs.ErrNotExist), true)
+	assertEqual(t, options.ErrorIs(wrapped, fs.ErrPermission), false)
+	assertEqual(t, options.ErrorIs(options.None[error](), fs.ErrNotExist), false)
+	assertEqual(t, options.ErrorIs(options.None[error](), nil), false)
+}
+
+func TestErrorAs(t *testing.T) {
+	pathErr := &fs.PathError{Op: "open", Path: "/foo", Err: fs.ErrNotExist}
+	wrapped := options.New(fmt.Errorf("failed: %w", error(pathErr)))
+
+	var target *fs.PathError
+	assertEqual(t, options.ErrorAs(wrapped, &target), true)
+	assertEqual(t, target, pathErr)
+
+	target = nil
+	assertEqual(t, options.ErrorAs(options.New(errors.New("other")), &target), false)
+	assertEqual(t, options.ErrorAs(options.None[error](), &target), false)
+	assertEqual(t, target, (*fs.PathError)(nil))
+}
@@ -0,0 +1,53 @@
+package options
+
+import "reflect"
+
+// Flag[T] is an adapter to use Option[T] as a command-line flag with the standard flag package.
+//
+// Flag[T] implements the [flag.Value] interface. The option is None until the flag is set,
+// so a flag that is not passed can be distinguished from a flag passed with the zero value.
+//
+// Flag[T] also implements the Value interface of [github.com/spf13/pflag], which requires the Type method,
+// so it can be used with pflag and CLIs based on it such as cobra.
+type Flag[T any] struct {
+	opt   Option[T]
+	parse func(string) (T, error)
+}
+
+// OptionFlag returns a new Flag[T] that parses flag values by the given function.
+// Register it by [flag.Var], and get the result by [Flag.Option] after parsing flags.
+func OptionFlag[T any](parse func(string) (T, error)) *Flag[T] {
+	return &Flag[T]{parse: parse}
+}
+
+// Option returns the flag value as an option.
+// If the flag has not been set, None is returned.
+func (f *Flag[T]) Option() Option[T] {
+	return f.opt
+}
+
+// Set implements the [flag.Value] interface.
+// The given string is parsed by the function given to [OptionFlag], and the option becomes present.
+func (f *Flag[T]) Set(s string) error {
+	v, err := f.parse(s)
+	if err != nil {
+		return err
+	}
+	f.opt = New(v)
+	return nil
+}
+
+// String implements the [flag.Value] interface.
+// If the flag has not been set, an empty string is returned.
+func (f *Flag[T]) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.opt.String()
+}
+
+// Type returns the name of the flag type, e.g. "option[int]".
+// This is used by pflag to show the type of the flag in the help message.
+func (f *Flag[T]) Type() string {
+	return "option[" + reflect.TypeOf((*T)(nil)).Elem().String() + "]"
+}
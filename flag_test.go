@@ -0,0 +1,64 @@
+package options_test
+
+import (
+	"flag"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestFlag(t *testing.T) {
+	newFlagSet := func() (*flag.FlagSet, *options.Flag[time.Duration], *options.Flag[int]) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		timeout := options.OptionFlag(time.ParseDuration)
+		count := options.OptionFlag(strconv.Atoi)
+		fs.Var(timeout, "timeout", "timeout of the operation")
+		fs.Var(count, "count", "number of retries")
+		return fs, timeout, count
+	}
+
+	fs1, timeout1, count1 := newFlagSet()
+	if err := fs1.Parse([]string{"-timeout=5s", "-count", "0"}); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, timeout1.Option(), options.New(5*time.Second))
+	assertEqual(t, count1.Option(), options.New(0))
+	assertEqual(t, timeout1.String(), "5s")
+
+	fs2, timeout2, count2 := newFlagSet()
+	if err := fs2.Parse(nil); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, timeout2.Option(), options.None[time.Duration]())
+	assertEqual(t, count2.Option(), options.None[int]())
+	assertEqual(t, timeout2.String(), "")
+
+	fs3, _, count3 := newFlagSet()
+	err := fs3.Parse([]string{"-count=foo"})
+	assertEqual(t, err.Error(), `invalid value "foo" for flag -count: strconv.Atoi: parsing "foo": invalid syntax`)
+	assertEqual(t, count3.Option(), options.None[int]())
+}
+
+// pflagValue is the same as the Value interface of github.com/spf13/pflag.
+type pflagValue interface {
+	String() string
+	Set(string) error
+	Type() string
+}
+
+func TestFlag_Type(t *testing.T) {
+	var v pflagValue = options.OptionFlag(strconv.Atoi)
+	assertEqual(t, v.Type(), "option[int]")
+	assertEqual(t, options.OptionFlag(time.ParseDuration).Type(), "option[time.Duration]")
+	parseAny := func(s string) (any, error) { return s, nil }
+	assertEqual(t, options.OptionFlag(parseAny).Type(), "option[interface {}]")
+
+	if err := v.Set("42"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, v.String(), "42")
+}
@@ -0,0 +1,30 @@
+//go:build go1.24
+
+package options
+
+import "hash/maphash"
+
+var hashSeed = maphash.MakeSeed()
+
+// Hash returns a hash value of the option.
+// This is a function rather than a method because T must be comparable.
+//
+// Hash is available only with Go 1.24 or later, since it depends on [maphash.WriteComparable].
+//
+// The hash value is computed from the presence and the value by [maphash.WriteComparable].
+// Equal options have the same hash value, and None always has the same hash value.
+// Different options may have the same hash value as with any 64-bit hash.
+//
+// The hash value is stable only within a single process since the seed is chosen randomly.
+// Do not persist the hash value or send it to other processes.
+func Hash[T comparable](o Option[T]) uint64 {
+	var h maphash.Hash
+	h.SetSeed(hashSeed)
+	if o.present {
+		h.WriteByte(1)
+		maphash.WriteComparable(&h, o.value)
+	} else {
+		h.WriteByte(0)
+	}
+	return h.Sum64()
+}
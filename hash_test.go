@@ -0,0 +1,25 @@
+//go:build go1.24
+
+package options_test
+
+import (
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestHash(t *testing.T) {
+	assertEqual(t, options.Hash(options.New(42)), options.Hash(options.New(42)))
+	assertEqual(t, options.Hash(options.New("hello")), options.Hash(options.New("hello")))
+	assertEqual(t, options.Hash(options.None[int]()), options.Hash(options.None[int]()))
+
+	if options.Hash(options.New(42)) == options.Hash(options.New(43)) {
+		t.Error("different values should have different hash values")
+	}
+	if options.Hash(options.New(0)) == options.Hash(options.None[int]()) {
+		t.Error("present zero value and None should have different hash values")
+	}
+	if options.Hash(options.New("")) == options.Hash(options.None[string]()) {
+		t.Error("present empty string and None should have different hash values")
+	}
+}
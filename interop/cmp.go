@@ -0,0 +1,35 @@
+package interop
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// CmpTransformer returns a [cmp.Option] that transforms any Option[T] into *T.
+//
+// This works like cmp.Transformer("options.Option", options.Pointer[T]) for all T at once,
+// so you don't need to instantiate options.Pointer for each type of option fields.
+//
+//	cmp.Diff(a, b, interop.CmpTransformer())
+func CmpTransformer() cmp.Option {
+	return cmp.FilterPath(isOptionPath, cmp.Transformer("options.Option", optionToPointer))
+}
+
+func isOptionPath(p cmp.Path) bool {
+	t := p.Last().Type()
+	return t != nil &&
+		t.Kind() == reflect.Struct &&
+		t.PkgPath() == "github.com/cybozu-go/options" &&
+		strings.HasPrefix(t.Name(), "Option[")
+}
+
+// optionToPointer calls Option[T].Pointer via reflection.
+// Pointer has a pointer receiver, so the option is copied into an addressable value first.
+func optionToPointer(o any) any {
+	v := reflect.ValueOf(o)
+	p := reflect.New(v.Type())
+	p.Elem().Set(v)
+	return p.MethodByName("Pointer").Call(nil)[0].Interface()
+}
@@ -7,6 +7,7 @@ require (
 	github.com/google/go-cmp v0.5.9
 	github.com/jmoiron/sqlx v1.3.5
 	github.com/mattn/go-sqlite3 v1.14.17
+	github.com/spf13/pflag v1.0.5
 )
 
 replace github.com/cybozu-go/options => ../
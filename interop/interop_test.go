@@ -1,7 +1,9 @@
 package interop_test
 
 import (
+	"io"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -9,8 +11,10 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/spf13/pflag"
 
 	"github.com/cybozu-go/options"
+	"github.com/cybozu-go/options/interop"
 )
 
 type Row struct {
@@ -109,6 +113,58 @@ func TestGoCmp_Transformer(t *testing.T) {
 	}
 }
 
+func TestCmpTransformer(t *testing.T) {
+	type Data struct {
+		Str    options.Option[string]
+		Nested options.Option[*TestData]
+	}
+
+	d1 := Data{
+		Str: options.New("hello"),
+		Nested: options.New(&TestData{
+			Value:  "test",
+			Nested: &NestedData{Value: "test"},
+		}),
+	}
+	d2 := Data{
+		Str: options.New("hello"),
+		Nested: options.New(&TestData{
+			Value:  "test",
+			Nested: &NestedData{Value: "test2"},
+		}),
+	}
+	d3 := Data{
+		Str:    options.None[string](),
+		Nested: options.None[*TestData](),
+	}
+
+	cmpopt := interop.CmpTransformer()
+	if diff := cmp.Diff(d1, d1, cmpopt); diff != "" {
+		t.Errorf("should be equal, but not:\n%s", diff)
+	}
+	if diff := cmp.Diff(d3, d3, cmpopt); diff != "" {
+		t.Errorf("should be equal, but not:\n%s", diff)
+	}
+	if diff := cmp.Diff(d1, d3, cmpopt); diff == "" {
+		t.Errorf("should have diff, but no diff found")
+	}
+
+	expectedDiff := `
+		interop_test.Data{
+			Str: Inverse(options.Option, &string("hello")),
+			Nested: options.Option[*github.com/cybozu-go/options/interop_test.TestData](Inverse(options.Option, &&interop_test.TestData{
+				Value:  "test",
+	  - 		Nested: &interop_test.NestedData{Value: "test"},
+	  + 		Nested: &interop_test.NestedData{Value: "test2"},
+			})),
+		}
+	`
+	actualDiff := cmp.Diff(d1, d2, cmpopt)
+	if !equalsIgnoringSpaces(actualDiff, expectedDiff) {
+		t.Errorf("unexpected diff.\n[expected]\n%s\n\n[actual]\n%s", expectedDiff, actualDiff)
+	}
+}
+
 func TestSQL(t *testing.T) {
 	testCases := []struct {
 		title    string
@@ -182,3 +238,64 @@ func TestSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestAssertSQLRoundTrip(t *testing.T) {
+	db, err := sqlx.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	interop.AssertSQLRoundTrip(t, db, options.New(int64(42)))
+	interop.AssertSQLRoundTrip(t, db, options.None[int64]())
+	interop.AssertSQLRoundTrip(t, db, options.New("hello"))
+	interop.AssertSQLRoundTrip(t, db, options.New(""))
+	interop.AssertSQLRoundTrip(t, db, options.None[string]())
+}
+
+func TestPflag(t *testing.T) {
+	newFlagSet := func() (*pflag.FlagSet, *options.Flag[time.Duration], *options.Flag[int]) {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		timeout := options.OptionFlag(time.ParseDuration)
+		count := options.OptionFlag(strconv.Atoi)
+		fs.Var(timeout, "timeout", "timeout of the operation")
+		fs.VarP(count, "count", "c", "number of retries")
+		return fs, timeout, count
+	}
+
+	fs1, timeout1, count1 := newFlagSet()
+	if err := fs1.Parse([]string{"--timeout=5s", "-c", "0"}); err != nil {
+		t.Fatal(err)
+	}
+	if timeout1.Option() != options.New(5*time.Second) {
+		t.Errorf("unexpected timeout: %v", timeout1.Option())
+	}
+	if count1.Option() != options.New(0) {
+		t.Errorf("unexpected count: %v", count1.Option())
+	}
+
+	fs2, timeout2, count2 := newFlagSet()
+	if err := fs2.Parse(nil); err != nil {
+		t.Fatal(err)
+	}
+	if timeout2.Option() != options.None[time.Duration]() {
+		t.Errorf("unexpected timeout: %v", timeout2.Option())
+	}
+	if count2.Option() != options.None[int]() {
+		t.Errorf("unexpected count: %v", count2.Option())
+	}
+
+	fs3, _, _ := newFlagSet()
+	if err := fs3.Parse([]string{"--count=foo"}); err == nil {
+		t.Error("should fail")
+	}
+
+	fs4, _, _ := newFlagSet()
+	expected := `  -c, --count option[int]               number of retries
+      --timeout option[time.Duration]   timeout of the operation
+`
+	if usage := fs4.FlagUsages(); usage != expected {
+		t.Errorf("unexpected usage:\n%s", usage)
+	}
+}
@@ -0,0 +1,58 @@
+// Package interop provides helpers to check interoperability of options with other libraries.
+package interop
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/cybozu-go/options"
+)
+
+var roundTripTableSeq atomic.Int64
+
+// AssertSQLRoundTrip inserts the given option into a temporary table, selects it back, and
+// reports a test error if the selected option is not equal to the inserted one.
+//
+// Use this function to check that your own T works with your database driver.
+//
+// The driver is assumed to support the following:
+//   - CREATE TEMPORARY TABLE with a column without type declaration, like SQLite does.
+//   - placeholders supported by [sqlx.DB.Rebind].
+//
+// Since temporary tables are visible only in the connection that created them,
+// all statements are executed on a single connection taken from db.
+func AssertSQLRoundTrip[T comparable](t testing.TB, db *sqlx.DB, value options.Option[T]) {
+	t.Helper()
+
+	ctx := context.Background()
+	conn, err := db.Connx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	table := fmt.Sprintf("options_roundtrip_%d", roundTripTableSeq.Add(1))
+	if _, err := conn.ExecContext(ctx, "CREATE TEMPORARY TABLE "+table+" (v)"); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_, _ = conn.ExecContext(ctx, "DROP TABLE "+table)
+	}()
+
+	if _, err := conn.ExecContext(ctx, conn.Rebind("INSERT INTO "+table+" (v) VALUES (?)"), value); err != nil {
+		t.Fatal(err)
+	}
+
+	var selected options.Option[T]
+	if err := conn.GetContext(ctx, &selected, "SELECT v FROM "+table); err != nil {
+		t.Fatal(err)
+	}
+
+	if selected != value {
+		t.Errorf("round trip mismatch: inserted='%#v', selected='%#v'", value, selected)
+	}
+}
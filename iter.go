@@ -0,0 +1,34 @@
+//go:build go1.23
+
+package options
+
+import "iter"
+
+// Enumerate returns an iterator over the present values of the given options.
+// Each value is yielded with its index in opts; None elements are skipped.
+func Enumerate[T any](opts []Option[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i, o := range opts {
+			if !o.present {
+				continue
+			}
+			if !yield(i, o.value) {
+				return
+			}
+		}
+	}
+}
+
+// CollectSeq collects the values of the options yielded by seq into a slice.
+// If seq yields None, the iteration stops there and None is returned.
+// If seq yields nothing, an empty non-nil slice is returned as a present option.
+func CollectSeq[T any](seq iter.Seq[Option[T]]) Option[[]T] {
+	values := []T{}
+	for o := range seq {
+		if !o.present {
+			return None[[]T]()
+		}
+		values = append(values, o.value)
+	}
+	return New(values)
+}
@@ -0,0 +1,83 @@
+//go:build go1.23
+
+package options_test
+
+import (
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func ExampleEnumerate() {
+	opts := []options.Option[string]{
+		options.New("foo"),
+		options.None[string](),
+		options.New("bar"),
+	}
+	for i, v := range options.Enumerate(opts) {
+		fmt.Println(i, v)
+	}
+
+	// Output:
+	// 0 foo
+	// 2 bar
+}
+
+func TestEnumerate(t *testing.T) {
+	opts := []options.Option[int]{
+		options.None[int](),
+		options.New(10),
+		options.None[int](),
+		options.None[int](),
+		options.New(40),
+		options.New(50),
+	}
+
+	var indices, values []int
+	for i, v := range options.Enumerate(opts) {
+		indices = append(indices, i)
+		values = append(values, v)
+	}
+	assertDeepEqual(t, indices, []int{1, 4, 5})
+	assertDeepEqual(t, values, []int{10, 40, 50})
+
+	// break stops the iteration
+	indices = nil
+	for i := range options.Enumerate(opts) {
+		indices = append(indices, i)
+		if i == 4 {
+			break
+		}
+	}
+	assertDeepEqual(t, indices, []int{1, 4})
+
+	for range options.Enumerate([]options.Option[int]{options.None[int]()}) {
+		t.Error("should not yield for None")
+	}
+}
+
+func TestCollectSeq(t *testing.T) {
+	seqOf := func(opts ...options.Option[int]) (iter.Seq[options.Option[int]], *int) {
+		yielded := 0
+		return func(yield func(options.Option[int]) bool) {
+			for _, o := range opts {
+				yielded++
+				if !yield(o) {
+					return
+				}
+			}
+		}, &yielded
+	}
+
+	seq1, _ := seqOf(options.New(1), options.New(2), options.New(3))
+	assertDeepEqual(t, options.CollectSeq(seq1), options.New([]int{1, 2, 3}))
+
+	seq2, yielded := seqOf(options.New(1), options.None[int](), options.New(3))
+	assertDeepEqual(t, options.CollectSeq(seq2), options.None[[]int]())
+	assertEqual(t, *yielded, 2)
+
+	seq3, _ := seqOf()
+	assertDeepEqual(t, options.CollectSeq(seq3), options.New([]int{}))
+}
@@ -0,0 +1,208 @@
+package options
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Verbose[T] is an Option[T] serialized into JSON as an object with the presence and the value.
+//
+// A present option is serialized as {"present":true,"value":...},
+// and None is serialized as {"present":false}.
+// This is useful to interoperate with systems that can't distinguish null from absence.
+//
+// Convert between Option[T] and Verbose[T] by type conversion, e.g. Verbose[T](o) and Option[T](v).
+type Verbose[T any] Option[T]
+
+type verboseJSON[T any] struct {
+	Present bool `json:"present"`
+	Value   T    `json:"value"`
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (v Verbose[T]) MarshalJSON() ([]byte, error) {
+	if v.present {
+		return json.Marshal(verboseJSON[T]{Present: true, Value: v.value})
+	} else {
+		return []byte(`{"present":false}`), nil
+	}
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+// If "present" is missing or false, "value" is ignored and None is set.
+func (v *Verbose[T]) UnmarshalJSON(bytes []byte) error {
+	var obj struct {
+		Present bool            `json:"present"`
+		Value   json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(bytes, &obj); err != nil {
+		return fmt.Errorf("Verbose[%T].UnmarshalJSON: %w", v.value, err)
+	}
+	if !obj.Present {
+		*v = Verbose[T](None[T]())
+		return nil
+	}
+
+	var value T
+	if len(obj.Value) > 0 {
+		if err := json.Unmarshal(obj.Value, &value); err != nil {
+			return fmt.Errorf("Verbose[%T].UnmarshalJSON: %w", v.value, err)
+		}
+	}
+	*v = Verbose[T](New(value))
+	return nil
+}
+
+// EmptyStringAsNone[T] is an Option[T] that is deserialized from an empty JSON string as None.
+//
+// This is useful for JSON converted from HTML forms or query strings, where "" means absence.
+// Other JSON values are deserialized in the same way as Option[T], so a non-empty string
+// that does not match T results in an error. EmptyStringAsNone[T] is serialized in the same way as Option[T].
+//
+// Convert between Option[T] and EmptyStringAsNone[T] by type conversion,
+// e.g. EmptyStringAsNone[T](o) and Option[T](v).
+type EmptyStringAsNone[T any] Option[T]
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (v EmptyStringAsNone[T]) MarshalJSON() ([]byte, error) {
+	return Option[T](v).MarshalJSON()
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (v *EmptyStringAsNone[T]) UnmarshalJSON(b []byte) error {
+	if string(bytes.TrimSpace(b)) == `""` {
+		*v = EmptyStringAsNone[T](None[T]())
+		return nil
+	}
+	return (*Option[T])(v).UnmarshalJSON(b)
+}
+
+// ArrayOption[T] is an Option[T] serialized into JSON as an array of at most one element.
+//
+// A present option is serialized as [value], and None is serialized as [].
+// On deserialization, both [value] and a bare value that is not an array are accepted as a present option,
+// and [] and null are deserialized as None. An array with more than one element results in an error.
+// Note that a JSON array is always treated as the array form even if T is a slice.
+// This is useful to interoperate with APIs that represent optional values in this way.
+//
+// Convert between Option[T] and ArrayOption[T] by type conversion, e.g. ArrayOption[T](o) and Option[T](v).
+type ArrayOption[T any] Option[T]
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (v ArrayOption[T]) MarshalJSON() ([]byte, error) {
+	if v.present {
+		return json.Marshal([1]T{v.value})
+	} else {
+		return []byte(`[]`), nil
+	}
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (v *ArrayOption[T]) UnmarshalJSON(b []byte) error {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) > 0 && trimmed[0] != '[' && string(trimmed) != "null" {
+		var value T
+		if err := json.Unmarshal(trimmed, &value); err != nil {
+			return fmt.Errorf("ArrayOption[%T].UnmarshalJSON: %w", v.value, err)
+		}
+		*v = ArrayOption[T](New(value))
+		return nil
+	}
+
+	var elems []json.RawMessage
+	if err := json.Unmarshal(b, &elems); err != nil {
+		return fmt.Errorf("ArrayOption[%T].UnmarshalJSON: %w", v.value, err)
+	}
+	switch len(elems) {
+	case 0:
+		*v = ArrayOption[T](None[T]())
+		return nil
+	case 1:
+		var value T
+		if err := json.Unmarshal(elems[0], &value); err != nil {
+			return fmt.Errorf("ArrayOption[%T].UnmarshalJSON: %w", v.value, err)
+		}
+		*v = ArrayOption[T](New(value))
+		return nil
+	default:
+		return fmt.Errorf("ArrayOption[%T].UnmarshalJSON: too many elements: %d", v.value, len(elems))
+	}
+}
+
+// KeyValue is an entry of [OrderedEntries].
+type KeyValue[K ~string, V any] struct {
+	Key   K
+	Value V
+}
+
+// OrderedEntries is a list of key-value pairs serialized into JSON as an object with the keys in the order of the list.
+//
+// encoding/json serializes maps with sorted keys. Use Option[OrderedEntries[K, V]] instead of Option[map[K]V]
+// if the order of the keys matters. On deserialization, the entries are stored in the order of the JSON object.
+// Duplicate keys are kept as they are.
+type OrderedEntries[K ~string, V any] []KeyValue[K, V]
+
+// MarshalJSON implements the [json.Marshaler] interface.
+// A nil OrderedEntries is serialized as null in the same way as a nil map.
+func (e OrderedEntries[K, V]) MarshalJSON() ([]byte, error) {
+	if e == nil {
+		return []byte("null"), nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, kv := range e {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(string(kv.Key))
+		if err != nil {
+			return nil, fmt.Errorf("OrderedEntries.MarshalJSON: %w", err)
+		}
+		value, err := json.Marshal(kv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("OrderedEntries.MarshalJSON: %w", err)
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the [json.Unmarshaler] interface.
+func (e *OrderedEntries[K, V]) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("OrderedEntries.UnmarshalJSON: %w", err)
+	}
+	if tok == nil {
+		*e = nil
+		return nil
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("OrderedEntries.UnmarshalJSON: expected an object, got %v", tok)
+	}
+
+	entries := OrderedEntries[K, V]{}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return fmt.Errorf("OrderedEntries.UnmarshalJSON: %w", err)
+		}
+		var kv KeyValue[K, V]
+		kv.Key = K(tok.(string))
+		if err := dec.Decode(&kv.Value); err != nil {
+			return fmt.Errorf("OrderedEntries.UnmarshalJSON: %w", err)
+		}
+		entries = append(entries, kv)
+	}
+	if _, err := dec.Token(); err != nil {
+		return fmt.Errorf("OrderedEntries.UnmarshalJSON: %w", err)
+	}
+	*e = entries
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build go1.24
+
+package options_test
+
+import (
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestJSONMarshal_OmitZero(t *testing.T) {
+	type payload struct {
+		Name options.Option[string] `json:"name,omitzero"`
+	}
+
+	assertEqual(t, marshal(t, payload{Name: options.None[string]()}), `{}`)
+	assertEqual(t, marshal(t, payload{Name: options.New("")}), `{"name":""}`)
+	assertEqual(t, marshal(t, payload{Name: options.New("foo")}), `{"name":"foo"}`)
+}
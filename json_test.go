@@ -0,0 +1,131 @@
+package options_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestVerbose(t *testing.T) {
+	some := options.Verbose[int](options.New(42))
+	assertEqual(t, marshal(t, some), `{"present":true,"value":42}`)
+
+	zero := options.Verbose[int](options.New(0))
+	assertEqual(t, marshal(t, zero), `{"present":true,"value":0}`)
+
+	none := options.Verbose[int](options.None[int]())
+	assertEqual(t, marshal(t, none), `{"present":false}`)
+
+	for _, v := range []options.Verbose[int]{some, zero, none} {
+		assertEqual(t, *unmarshal[options.Verbose[int]](t, marshal(t, v)), v)
+	}
+
+	assertEqual(t, options.Option[int](*unmarshal[options.Verbose[int]](t, `{"present":false,"value":42}`)), options.None[int]())
+	assertEqual(t, options.Option[int](*unmarshal[options.Verbose[int]](t, `{}`)), options.None[int]())
+	assertEqual(t, options.Option[int](*unmarshal[options.Verbose[int]](t, `{"present":true}`)), options.New(0))
+
+	type data struct {
+		Name options.Verbose[string] `json:"name"`
+	}
+	assertEqual(t, marshal(t, data{Name: options.Verbose[string](options.New("alice"))}), `{"name":{"present":true,"value":"alice"}}`)
+	assertEqual(t, unmarshal[data](t, `{"name":{"present":true,"value":"alice"}}`).Name, options.Verbose[string](options.New("alice")))
+
+	var v options.Verbose[int]
+	if err := v.UnmarshalJSON([]byte(`{"present":true,"value":"foo"}`)); err == nil {
+		t.Error("should fail")
+	}
+}
+
+func TestEmptyStringAsNone(t *testing.T) {
+	type form struct {
+		Age  options.EmptyStringAsNone[int]    `json:"age"`
+		Name options.EmptyStringAsNone[string] `json:"name"`
+	}
+
+	f1 := unmarshal[form](t, `{"age":"","name":""}`)
+	assertEqual(t, options.Option[int](f1.Age), options.None[int]())
+	assertEqual(t, options.Option[string](f1.Name), options.None[string]())
+
+	f2 := unmarshal[form](t, `{"age":42,"name":"alice"}`)
+	assertEqual(t, options.Option[int](f2.Age), options.New(42))
+	assertEqual(t, options.Option[string](f2.Name), options.New("alice"))
+
+	f3 := unmarshal[form](t, `{"age":null}`)
+	assertEqual(t, options.Option[int](f3.Age), options.None[int]())
+
+	assertEqual(t, marshal(t, f1), `{"age":null,"name":null}`)
+	assertEqual(t, marshal(t, f2), `{"age":42,"name":"alice"}`)
+
+	var f4 form
+	err := json.Unmarshal([]byte(`{"age":"foo"}`), &f4)
+	var typeErr *options.JSONTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Option[int] itself is strict.
+	var opt options.Option[int]
+	if err := json.Unmarshal([]byte(`""`), &opt); err == nil {
+		t.Error("should fail")
+	}
+}
+
+func TestArrayOption(t *testing.T) {
+	some := options.ArrayOption[int](options.New(42))
+	none := options.ArrayOption[int](options.None[int]())
+
+	assertEqual(t, marshal(t, some), `[42]`)
+	assertEqual(t, marshal(t, options.ArrayOption[int](options.New(0))), `[0]`)
+	assertEqual(t, marshal(t, none), `[]`)
+	assertEqual(t, marshal(t, options.ArrayOption[[]int](options.New([]int{1, 2}))), `[[1,2]]`)
+
+	assertEqual(t, *unmarshal[options.ArrayOption[int]](t, `[42]`), some)
+	assertEqual(t, *unmarshal[options.ArrayOption[int]](t, ` [ ] `), none)
+	assertEqual(t, *unmarshal[options.ArrayOption[int]](t, `null`), none)
+	assertEqual(t, options.Option[int](*unmarshal[options.ArrayOption[int]](t, `[0]`)), options.New(0))
+
+	var v options.ArrayOption[int]
+	err := json.Unmarshal([]byte(`[1,2]`), &v)
+	assertEqual(t, err.Error(), "ArrayOption[int].UnmarshalJSON: too many elements: 2")
+	if err := json.Unmarshal([]byte(`["foo"]`), &v); err == nil {
+		t.Error("should fail")
+	}
+	if err := json.Unmarshal([]byte(`"foo"`), &v); err == nil {
+		t.Error("should fail")
+	}
+
+	assertEqual(t, *unmarshal[options.ArrayOption[int]](t, `42`), some)
+	assertEqual(t, *unmarshal[options.ArrayOption[int]](t, ` 0 `), options.ArrayOption[int](options.New(0)))
+	assertEqual(t, *unmarshal[options.ArrayOption[string]](t, `"foo"`), options.ArrayOption[string](options.New("foo")))
+	assertDeepEqual(t, *unmarshal[options.ArrayOption[map[string]int]](t, `{"a":1}`),
+		options.ArrayOption[map[string]int](options.New(map[string]int{"a": 1})))
+}
+
+func TestOrderedEntries(t *testing.T) {
+	type entries = options.OrderedEntries[string, int]
+	type kv = options.KeyValue[string, int]
+
+	e := entries{{Key: "zeta", Value: 1}, {Key: "alpha", Value: 2}, {Key: "mu", Value: 3}}
+	assertEqual(t, marshal(t, e), `{"zeta":1,"alpha":2,"mu":3}`)
+	assertEqual(t, marshal(t, options.New(e)), `{"zeta":1,"alpha":2,"mu":3}`)
+	assertEqual(t, marshal(t, options.None[entries]()), `null`)
+	assertEqual(t, marshal(t, entries{}), `{}`)
+	assertEqual(t, marshal(t, entries(nil)), `null`)
+	assertEqual(t, marshal(t, options.OrderedEntries[string, string]{{Key: `"quoted"`, Value: "x"}}), `{"\"quoted\"":"x"}`)
+
+	opt := unmarshal[options.Option[entries]](t, ` { "zeta" : 1, "alpha": 2, "mu": 3 } `)
+	assertDeepEqual(t, *opt, options.New(e))
+	assertDeepEqual(t, *unmarshal[options.Option[entries]](t, `null`), options.None[entries]())
+	assertDeepEqual(t, *unmarshal[entries](t, `{}`), entries{})
+	assertDeepEqual(t, *unmarshal[entries](t, `{"a":1,"a":2}`), entries{kv{Key: "a", Value: 1}, kv{Key: "a", Value: 2}})
+
+	var invalid entries
+	if err := json.Unmarshal([]byte(`[1,2]`), &invalid); err == nil {
+		t.Error("should fail")
+	}
+	if err := json.Unmarshal([]byte(`{"a":"foo"}`), &invalid); err == nil {
+		t.Error("should fail")
+	}
+}
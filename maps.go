@@ -0,0 +1,48 @@
+package options
+
+// MapValuesOption returns a new map by applying the given function to the present values of the map.
+// None values are kept as None.
+func MapValuesOption[K comparable, A any, B any](m map[K]Option[A], f func(A) B) map[K]Option[B] {
+	if m == nil {
+		return nil
+	}
+	result := make(map[K]Option[B], len(m))
+	for k, o := range m {
+		result[k] = Map(o, f)
+	}
+	return result
+}
+
+// PruneNones returns a new map containing only the present entries of the given map, unwrapped.
+// The given map is not modified. If the given map is nil, an empty non-nil map is returned.
+//
+// encoding/json serializes None map values as null; the omitempty and omitzero options have no
+// effect on map values. Use this function to drop None entries before marshaling a map.
+func PruneNones[K comparable, V any](m map[K]Option[V]) map[K]V {
+	result := make(map[K]V, len(m))
+	for k, o := range m {
+		if o.present {
+			result[k] = o.value
+		}
+	}
+	return result
+}
+
+// Lookup returns the value for the given key of the map as an option.
+// If the key does not exist in the map, None is returned.
+func Lookup[K comparable, V any](m map[K]V, key K) Option[V] {
+	v, ok := m[key]
+	return FromTuple(v, ok)
+}
+
+// LookupPtr returns the value pointed by the value for the given key of the map as an option.
+// If the key does not exist in the map or the value is nil, None is returned.
+func LookupPtr[K comparable, V any](m map[K]*V, key K) Option[V] {
+	return FromPointer(m[key])
+}
+
+// FilterMapNone is an alias of [PruneNones], named as the map version of [FilterPresent].
+// PruneNones is the canonical name; prefer it in new code.
+func FilterMapNone[K comparable, V any](m map[K]Option[V]) map[K]V {
+	return PruneNones(m)
+}
@@ -0,0 +1,78 @@
+package options_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestMapValuesOption(t *testing.T) {
+	m := map[string]options.Option[string]{
+		"foo": options.New("hello"),
+		"bar": options.None[string](),
+		"baz": options.New(""),
+	}
+	actual := options.MapValuesOption(m, strings.ToUpper)
+	assertDeepEqual(t, actual, map[string]options.Option[string]{
+		"foo": options.New("HELLO"),
+		"bar": options.None[string](),
+		"baz": options.New(""),
+	})
+	assertEqual(t, m["foo"], options.New("hello"))
+
+	lengths := options.MapValuesOption(m, func(s string) int { return len(s) })
+	assertDeepEqual(t, lengths, map[string]options.Option[int]{
+		"foo": options.New(5),
+		"bar": options.None[int](),
+		"baz": options.New(0),
+	})
+
+	assertDeepEqual(t, options.MapValuesOption(map[string]options.Option[string](nil), strings.ToUpper), nil)
+}
+
+func TestPruneNones(t *testing.T) {
+	config := map[string]options.Option[int]{
+		"timeout": options.New(30),
+		"retries": options.None[int](),
+		"delay":   options.New(0),
+	}
+	assertEqual(t, marshal(t, config), `{"delay":0,"retries":null,"timeout":30}`)
+
+	pruned := options.PruneNones(config)
+	assertDeepEqual(t, pruned, map[string]int{"timeout": 30, "delay": 0})
+	assertEqual(t, marshal(t, pruned), `{"delay":0,"timeout":30}`)
+	assertEqual(t, len(config), 3)
+
+	assertDeepEqual(t, options.PruneNones(map[string]options.Option[int](nil)), map[string]int{})
+}
+
+func TestLookup(t *testing.T) {
+	m := map[string]int{"foo": 1, "zero": 0}
+	assertEqual(t, options.Lookup(m, "foo"), options.New(1))
+	assertEqual(t, options.Lookup(m, "zero"), options.New(0))
+	assertEqual(t, options.Lookup(m, "bar"), options.None[int]())
+	assertEqual(t, options.Lookup(map[string]int(nil), "foo"), options.None[int]())
+}
+
+func TestLookupPtr(t *testing.T) {
+	one := 1
+	m := map[string]*int{"foo": &one, "nil": nil}
+	assertEqual(t, options.LookupPtr(m, "foo"), options.New(1))
+	assertEqual(t, options.LookupPtr(m, "nil"), options.None[int]())
+	assertEqual(t, options.LookupPtr(m, "bar"), options.None[int]())
+}
+
+func TestFilterMapNone(t *testing.T) {
+	m := map[string]options.Option[string]{
+		"foo": options.New("hello"),
+		"bar": options.None[string](),
+		"baz": options.New(""),
+	}
+	assertDeepEqual(t, options.FilterMapNone(m), map[string]string{"foo": "hello", "baz": ""})
+	assertEqual(t, len(m), 3)
+
+	filtered := options.FilterMapNone(map[string]options.Option[string](nil))
+	assertEqual(t, filtered != nil, true)
+	assertEqual(t, len(filtered), 0)
+}
@@ -0,0 +1,12 @@
+module github.com/cybozu-go/options/msgpackx
+
+go 1.21
+
+require (
+	github.com/cybozu-go/options v0.0.0
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+)
+
+require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
+
+replace github.com/cybozu-go/options => ../
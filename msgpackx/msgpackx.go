@@ -0,0 +1,37 @@
+// Package msgpackx provides MessagePack support of options by [vmihailenco/msgpack].
+//
+// This package is provided as a separate module to keep the options module free from dependencies.
+//
+// [vmihailenco/msgpack]: https://github.com/vmihailenco/msgpack
+package msgpackx
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/cybozu-go/options"
+)
+
+// Register registers the encoder and the decoder of Option[T] to msgpack.
+// After registration, a present Option[T] is encoded as its value, and None is encoded as nil.
+//
+// Register should be called once for each T, typically in an init function.
+func Register[T any]() {
+	msgpack.Register(options.None[T](), encode[T], decode[T])
+}
+
+func encode[T any](e *msgpack.Encoder, v reflect.Value) error {
+	o := v.Interface().(options.Option[T])
+	return e.Encode(o.Pointer())
+}
+
+func decode[T any](d *msgpack.Decoder, v reflect.Value) error {
+	var p *T
+	if err := d.Decode(&p); err != nil {
+		return fmt.Errorf("Option[%T].DecodeMsgpack: %w", *new(T), err)
+	}
+	v.Set(reflect.ValueOf(options.FromPointer(p)))
+	return nil
+}
@@ -0,0 +1,67 @@
+package msgpackx_test
+
+import (
+	"testing"
+
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/cybozu-go/options"
+	"github.com/cybozu-go/options/msgpackx"
+)
+
+func init() {
+	msgpackx.Register[int]()
+	msgpackx.Register[string]()
+}
+
+type Data struct {
+	Num options.Option[int]
+	Str options.Option[string]
+}
+
+func TestRoundTrip(t *testing.T) {
+	testCases := []Data{
+		{Num: options.New(42), Str: options.New("hello")},
+		{Num: options.New(0), Str: options.New("")},
+		{Num: options.None[int](), Str: options.None[string]()},
+	}
+	for _, tc := range testCases {
+		b, err := msgpack.Marshal(tc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Data
+		if err := msgpack.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded != tc {
+			t.Errorf("not equal: expected='%#v', actual='%#v'", tc, decoded)
+		}
+	}
+}
+
+func TestEncodeNone(t *testing.T) {
+	b, err := msgpack.Marshal(options.None[int]())
+	if err != nil {
+		t.Fatal(err)
+	}
+	nilBytes, err := msgpack.Marshal(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != string(nilBytes) {
+		t.Errorf("None should be encoded as nil: %x", b)
+	}
+
+	b, err = msgpack.Marshal(options.New(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	intBytes, err := msgpack.Marshal(42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != string(intBytes) {
+		t.Errorf("present option should be encoded as its value: %x", b)
+	}
+}
@@ -0,0 +1,50 @@
+package options
+
+// Numeric is a constraint that permits any integer or floating-point type.
+type Numeric interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Add returns the sum of the values of the given options.
+// If either of the options is None, None is returned.
+// Use [Sum] to treat None as zero.
+func Add[T Numeric](a, b Option[T]) Option[T] {
+	if a.present && b.present {
+		return New(a.value + b.value)
+	} else {
+		return None[T]()
+	}
+}
+
+// Sub returns the difference of the values of the given options.
+// If either of the options is None, None is returned.
+func Sub[T Numeric](a, b Option[T]) Option[T] {
+	if a.present && b.present {
+		return New(a.value - b.value)
+	} else {
+		return None[T]()
+	}
+}
+
+// Mul returns the product of the values of the given options.
+// If either of the options is None, None is returned.
+func Mul[T Numeric](a, b Option[T]) Option[T] {
+	if a.present && b.present {
+		return New(a.value * b.value)
+	} else {
+		return None[T]()
+	}
+}
+
+// Sum returns the sum of the values of the given options.
+// Unlike [Add], None is treated as zero, i.e. it is skipped.
+// If no option is present, zero is returned.
+func Sum[T Numeric](opts ...Option[T]) T {
+	var sum T
+	for _, o := range opts {
+		sum += o.value
+	}
+	return sum
+}
@@ -0,0 +1,49 @@
+package options_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func ExampleSum() {
+	items := []options.Option[int]{
+		options.New(100),
+		options.None[int](),
+		options.New(250),
+	}
+	fmt.Println(options.Sum(items...))
+
+	// Output:
+	// 350
+}
+
+func TestAdd(t *testing.T) {
+	assertEqual(t, options.Add(options.New(1), options.New(2)), options.New(3))
+	assertEqual(t, options.Add(options.New(1), options.None[int]()), options.None[int]())
+	assertEqual(t, options.Add(options.None[int](), options.New(2)), options.None[int]())
+	assertEqual(t, options.Add(options.None[int](), options.None[int]()), options.None[int]())
+	assertEqual(t, options.Add(options.New(1.5), options.New(2.25)), options.New(3.75))
+}
+
+func TestSub(t *testing.T) {
+	assertEqual(t, options.Sub(options.New(1), options.New(2)), options.New(-1))
+	assertEqual(t, options.Sub(options.New(1), options.None[int]()), options.None[int]())
+	assertEqual(t, options.Sub(options.None[int](), options.New(2)), options.None[int]())
+	assertEqual(t, options.Sub(options.None[int](), options.None[int]()), options.None[int]())
+}
+
+func TestMul(t *testing.T) {
+	assertEqual(t, options.Mul(options.New(3), options.New(4)), options.New(12))
+	assertEqual(t, options.Mul(options.New(3), options.None[int]()), options.None[int]())
+	assertEqual(t, options.Mul(options.None[int](), options.New(4)), options.None[int]())
+	assertEqual(t, options.Mul(options.None[int](), options.None[int]()), options.None[int]())
+}
+
+func TestSum(t *testing.T) {
+	assertEqual(t, options.Sum(options.New(1), options.None[int](), options.New(2)), 3)
+	assertEqual(t, options.Sum(options.None[int](), options.None[int]()), 0)
+	assertEqual(t, options.Sum[int](), 0)
+	assertEqual(t, options.Sum(options.New[uint8](200), options.New[uint8](50)), uint8(250))
+}
@@ -2,15 +2,25 @@ package options
 
 import (
 	"database/sql/driver"
+	"encoding"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"runtime"
+	"strings"
+	"unicode/utf8"
 )
 
 // Option[T] represents an optional value of type T.
 //
 // Options that have no value are called [None].
 // The zero value of Option[T] is None.
+//
+// Options can be compared by == operator if T is comparable.
+// If T is an interface type, == panics when the dynamic type of the values is not comparable.
 type Option[T any] struct {
 	// invariant: !present => value == <zero value of T>
 	value   T
@@ -30,6 +40,21 @@ func None[T any]() Option[T] {
 	return Option[T]{}
 }
 
+// NewOrNone creates Option[T] from variadic arguments.
+// If no value is given, None is returned.
+// If exactly one value is given, a new Option[T] with the value is returned.
+// If more than one value is given, NewOrNone panics.
+func NewOrNone[T any](values ...T) Option[T] {
+	switch len(values) {
+	case 0:
+		return None[T]()
+	case 1:
+		return New(values[0])
+	default:
+		panic(fmt.Errorf("NewOrNone: too many values: %d", len(values)))
+	}
+}
+
 // FromPointer creates Option[T] from a pointer.
 // If the pointer is nil, None is returned.
 // Otherwise, a new Option[T] with the pointed value is returned.
@@ -41,6 +66,34 @@ func FromPointer[T any](ptr *T) Option[T] {
 	}
 }
 
+// Deref converts Option[*T] into Option[T].
+// If the option is None or the wrapped pointer is nil, None is returned.
+// Otherwise, a new Option[T] with the pointed value is returned.
+func Deref[T any](o Option[*T]) Option[T] {
+	return FromPointer(o.value)
+}
+
+// FlattenPtr converts *Option[T] into Option[T].
+// If the pointer is nil, None is returned.
+// Otherwise, the pointed option is returned.
+func FlattenPtr[T any](p *Option[T]) Option[T] {
+	if p == nil {
+		return None[T]()
+	} else {
+		return *p
+	}
+}
+
+// MustFromPointer creates Option[T] from a pointer.
+// If the pointer is nil, MustFromPointer panics.
+// Otherwise, a new Option[T] with the pointed value is returned.
+func MustFromPointer[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		panic(fmt.Errorf("MustFromPointer: nil pointer of type %T", ptr))
+	}
+	return New(*ptr)
+}
+
 // FromTuple creates Option[T] from a tuple of (T, bool).
 // If the bool is true, a new Option[T] with the given value is returned.
 // Otherwise, None is returned.
@@ -52,6 +105,94 @@ func FromTuple[T any](value T, present bool) Option[T] {
 	}
 }
 
+// FromError creates Option[T] from a tuple of (T, error).
+// If the error is nil, a new Option[T] with the given value is returned.
+// Otherwise, None is returned and the error is dropped.
+func FromError[T any](value T, err error) Option[T] {
+	if err == nil {
+		return New(value)
+	} else {
+		return None[T]()
+	}
+}
+
+// FromErrorFunc creates Option[T] from the result of calling f.
+// See [FromError] for details. The error returned by f is dropped.
+func FromErrorFunc[T any](f func() (T, error)) Option[T] {
+	return FromError(f())
+}
+
+// ParseInto creates Option[T] by parsing the given string with the given function.
+// If the string is empty, None and a nil error are returned without calling parse.
+// If parse returns an error, None and the error are returned.
+func ParseInto[T any](s string, parse func(string) (T, error)) (Option[T], error) {
+	if s == "" {
+		return None[T](), nil
+	}
+	v, err := parse(s)
+	if err != nil {
+		return None[T](), err
+	}
+	return New(v), nil
+}
+
+// NonEmpty creates Option[[]T] from a slice.
+// If the slice is nil or empty, None is returned.
+// A slice of zero length is treated as empty even if its capacity is not zero.
+func NonEmpty[T any](s []T) Option[[]T] {
+	if len(s) == 0 {
+		return None[[]T]()
+	} else {
+		return New(s)
+	}
+}
+
+// FromNillableSlice creates Option[[]T] from a slice.
+// If the slice is nil, None is returned.
+// A non-nil empty slice results in a present option, unlike [NonEmpty].
+func FromNillableSlice[T any](s []T) Option[[]T] {
+	if s == nil {
+		return None[[]T]()
+	} else {
+		return New(s)
+	}
+}
+
+// FromNillableMap creates Option[map[K]V] from a map.
+// If the map is nil, None is returned.
+// A non-nil empty map results in a present option.
+func FromNillableMap[K comparable, V any](m map[K]V) Option[map[K]V] {
+	if m == nil {
+		return None[map[K]V]()
+	} else {
+		return New(m)
+	}
+}
+
+// NonEmptyString creates Option[string] from a string.
+// If the string is empty, None is returned.
+func NonEmptyString(s string) Option[string] {
+	if s == "" {
+		return None[string]()
+	} else {
+		return New(s)
+	}
+}
+
+// FromChan receives a value from the channel without blocking.
+// If a value is ready, a new Option[T] with the value is returned.
+// If no value is ready or the channel is closed, None is returned immediately.
+//
+// This is the same as a select statement with a receive case and a default case.
+func FromChan[T any](ch <-chan T) Option[T] {
+	select {
+	case v, ok := <-ch:
+		return FromTuple(v, ok)
+	default:
+		return None[T]()
+	}
+}
+
 // IsPresent returns true if the option has a value.
 func (o *Option[T]) IsPresent() bool {
 	return o.present
@@ -62,17 +203,67 @@ func (o *Option[T]) IsNone() bool {
 	return !o.present
 }
 
+// IsZero returns true if the option is None.
+//
+// This method makes the omitzero option of encoding/json, available since Go 1.24, omit None.
+// Unlike omitempty, a present zero value, such as an empty string, is not omitted.
+func (o Option[T]) IsZero() bool {
+	return !o.present
+}
+
+// UnwrapError is the value [Option.Unwrap] and [Option.OrPanic] panic with when the option is None.
+type UnwrapError struct {
+	// TypeName is the name of the type T of Option[T].
+	TypeName string
+	// Method is the name of the method that panicked, i.e. "Unwrap" or "OrPanic".
+	Method string
+	// Caller is the position of the caller of OrPanic in the form of "file:line".
+	// It is empty for Unwrap, or if the position is unknown.
+	Caller string
+}
+
+// Error implements the error interface.
+func (e UnwrapError) Error() string {
+	if e.Caller != "" {
+		return fmt.Sprintf("Option[%s].%s: unwrapping None value at %s", e.TypeName, e.Method, e.Caller)
+	} else {
+		return fmt.Sprintf("Option[%s].%s: unwrapping None value", e.TypeName, e.Method)
+	}
+}
+
+// typeName returns the name of the type T, e.g. "int" and "error".
+// Unlike the %T verb of a zero value, it works for interface types.
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
 // Unwrap returns the value of the option.
-// If the option is None, Unwrap panics.
+// If the option is None, Unwrap panics with [UnwrapError].
 // You should check the option with [Option.IsPresent] before calling this method.
 func (o *Option[T]) Unwrap() T {
 	if o.present {
 		return o.value
 	} else {
-		panic(fmt.Errorf("Option[%T].Unwrap: unwrapping None value", o.value))
+		panic(UnwrapError{TypeName: typeName[T](), Method: "Unwrap"})
 	}
 }
 
+// OrPanic returns the value of the option.
+// If the option is None, OrPanic panics with [UnwrapError] including the file and line of the caller.
+//
+// Use this method instead of [Option.Unwrap] for invariant checks,
+// where it is helpful to know which call site has failed.
+func (o Option[T]) OrPanic() T {
+	if o.present {
+		return o.value
+	}
+	err := UnwrapError{TypeName: typeName[T](), Method: "OrPanic"}
+	if _, file, line, ok := runtime.Caller(1); ok {
+		err.Caller = fmt.Sprintf("%s:%d", file, line)
+	}
+	panic(err)
+}
+
 // UnwrapOr returns the value of the option.
 // If the option is None, the given default value is returned.
 func (o *Option[T]) UnwrapOr(defaultValue T) T {
@@ -89,8 +280,171 @@ func (o *Option[T]) UnwrapOrZero() T {
 	return o.value
 }
 
+// UnwrapOrElseErr returns the value of the option and a nil error.
+// If the option is None, the result of calling f is returned.
+// f is not called if the option is present.
+func (o Option[T]) UnwrapOrElseErr(f func() (T, error)) (T, error) {
+	if o.present {
+		return o.value, nil
+	} else {
+		return f()
+	}
+}
+
+// WithDefault returns the option as is if it is present.
+// If the option is None, a new option with the given default value is returned.
+// Unlike [Option.UnwrapOr], the result is an option, which is always present.
+func (o Option[T]) WithDefault(defaultValue T) Option[T] {
+	if o.present {
+		return o
+	} else {
+		return New(defaultValue)
+	}
+}
+
+// InspectNone calls the given function if the option is None, and returns the option as is.
+// f is not called if the option is present.
+func (o Option[T]) InspectNone(f func()) Option[T] {
+	if !o.present {
+		f()
+	}
+	return o
+}
+
+// ForEach calls the given function with the value of the option if the option is present.
+// If the option is None, ForEach does nothing.
+// Unlike [Option.InspectNone], ForEach returns nothing, so it is meant to end a chain of calls.
+func (o Option[T]) ForEach(f func(T)) {
+	if o.present {
+		f(o.value)
+	}
+}
+
+// UnwrapOrDefault returns the value of the option if the value satisfies the given predicate.
+// If the option is None or pred returns false, the given default value is returned.
+// pred is not called if the option is None.
+//
+// Note that a present zero value is passed to pred as is.
+// If the zero value should be treated as absent, pred should reject it.
+func (o Option[T]) UnwrapOrDefault(pred func(T) bool, defaultValue T) T {
+	if o.present && pred(o.value) {
+		return o.value
+	} else {
+		return defaultValue
+	}
+}
+
+// UnwrapUnchecked returns the value of the option without checking its presence.
+//
+// WARNING: The result is unspecified if the option is None.
+// Call this method only after checking the option with [Option.IsPresent],
+// and only in performance-critical code where the check in [Option.Unwrap] matters.
+// Use [Option.UnwrapOrZero] if you want the zero value for None.
+func (o Option[T]) UnwrapUnchecked() T {
+	return o.value
+}
+
+// Set sets the given value to the option.
+// The option is always present after calling this method.
+func (o *Option[T]) Set(value T) {
+	*o = New(value)
+}
+
+// SetZero sets the zero value of T to the option.
+// Unlike [Option.Clear], the option is present after calling this method.
+func (o *Option[T]) SetZero() {
+	var zero T
+	*o = New(zero)
+}
+
+// Clear resets the option to None.
+func (o *Option[T]) Clear() {
+	*o = None[T]()
+}
+
+// Swap exchanges the values and the presence of the options pointed by a and b.
+func Swap[T any](a, b *Option[T]) {
+	*a, *b = *b, *a
+}
+
+// TakeIf takes the value out of the option if the value satisfies the given predicate.
+// If the option is present and pred returns true, the value is returned as a present option
+// and the option is set to None.
+// Otherwise, None is returned and the option is left unchanged.
+func (o *Option[T]) TakeIf(pred func(T) bool) Option[T] {
+	if o.present && pred(o.value) {
+		taken := *o
+		*o = None[T]()
+		return taken
+	} else {
+		return None[T]()
+	}
+}
+
+// ErrNone is returned by [Option.TryUnwrap] when the option is None.
+var ErrNone = errors.New("option is None")
+
+// TryUnwrap returns the value of the option and a nil error.
+// If the option is None, the zero value of T and an error wrapping [ErrNone] are returned.
+// This is the non-panicking version of [Option.Unwrap].
+func (o Option[T]) TryUnwrap() (T, error) {
+	if o.present {
+		return o.value, nil
+	} else {
+		return o.value, fmt.Errorf("Option[%s].TryUnwrap: %w", typeName[T](), ErrNone)
+	}
+}
+
+// OkOr returns the value of the option and a nil error.
+// If the option is None, the zero value of T and the given error are returned.
+func (o Option[T]) OkOr(err error) (T, error) {
+	if o.present {
+		return o.value, nil
+	} else {
+		return o.value, err
+	}
+}
+
+// OkOrElse returns the value of the option and a nil error.
+// If the option is None, the zero value of T and the error returned by f are returned.
+func (o Option[T]) OkOrElse(f func() error) (T, error) {
+	if o.present {
+		return o.value, nil
+	} else {
+		return o.value, f()
+	}
+}
+
+// ErrRequired is returned by [Option.Validate] when a required option is None.
+var ErrRequired = errors.New("required value is absent")
+
+// Validate validates the option.
+//
+// If the option is None, [ErrRequired] is returned if required is true, and nil is returned otherwise.
+// If the option is present, all the given checks are called with the value,
+// and the errors returned by them are joined by [errors.Join].
+func (o Option[T]) Validate(required bool, checks ...func(T) error) error {
+	if !o.present {
+		if required {
+			return ErrRequired
+		}
+		return nil
+	}
+
+	var errs []error
+	for _, check := range checks {
+		if err := check(o.value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // Pointer returns a pointer to the wrapped value of the option.
 // If the option is None, nil is returned.
+//
+// The pointer refers to the storage of the option, so writing through it modifies the option.
+// Use [Option.PtrCopy] to get a pointer that does not alias the option.
 func (o *Option[T]) Pointer() *T {
 	if o.present {
 		return &o.value
@@ -99,6 +453,52 @@ func (o *Option[T]) Pointer() *T {
 	}
 }
 
+// PtrCopy returns a pointer to a newly allocated copy of the wrapped value.
+// If the option is None, nil is returned.
+//
+// Unlike [Option.Pointer], modification through the pointer does not affect the option.
+// Note that the value is copied shallowly, e.g. a wrapped slice shares its elements.
+func (o Option[T]) PtrCopy() *T {
+	if o.present {
+		v := o.value
+		return &v
+	} else {
+		return nil
+	}
+}
+
+// ToAny converts the option into Option[any].
+// If the option is None, None is returned.
+func (o Option[T]) ToAny() Option[any] {
+	if o.present {
+		return New[any](o.value)
+	} else {
+		return None[any]()
+	}
+}
+
+// AsType converts Option[any] into Option[U] by type assertion.
+// If the option is None or the value is not of type U, None is returned.
+// Use [TryAsType] to distinguish these cases.
+func AsType[U any](o Option[any]) Option[U] {
+	v, ok := o.value.(U)
+	return FromTuple(v, ok && o.present)
+}
+
+// TryAsType converts Option[any] into Option[U] by type assertion.
+// If the option is None, None and a nil error are returned.
+// If the value is not of type U, None and an error are returned.
+func TryAsType[U any](o Option[any]) (Option[U], error) {
+	if !o.present {
+		return None[U](), nil
+	}
+	v, ok := o.value.(U)
+	if !ok {
+		return None[U](), fmt.Errorf("TryAsType: %T is not %v", o.value, reflect.TypeOf((*U)(nil)).Elem())
+	}
+	return New(v), nil
+}
+
 // Map returns a new option by applying the given function to the value of the option.
 // If the option is None, None is returned.
 func Map[A any, B any](o Option[A], f func(A) B) Option[B] {
@@ -109,6 +509,136 @@ func Map[A any, B any](o Option[A], f func(A) B) Option[B] {
 	}
 }
 
+// GetField returns a new option of a field of the value of the option.
+// If the option is None, None is returned.
+//
+// This is the same as [Map], but reads naturally for field projection.
+func GetField[T any, F any](o Option[T], f func(T) F) Option[F] {
+	return Map(o, f)
+}
+
+// GetFieldOpt returns an optional field of the value of the option.
+// If the option is None, None is returned.
+func GetFieldOpt[T any, F any](o Option[T], f func(T) Option[F]) Option[F] {
+	if o.present {
+		return f(o.value)
+	} else {
+		return None[F]()
+	}
+}
+
+// Compose2 returns a function that applies f and then g to the value returned by f.
+// If f returns None, the composed function returns None without calling g.
+func Compose2[A any, B any, C any](f func(A) Option[B], g func(B) Option[C]) func(A) Option[C] {
+	return func(a A) Option[C] {
+		b := f(a)
+		if !b.present {
+			return None[C]()
+		}
+		return g(b.value)
+	}
+}
+
+// MapOr returns the result of applying the given function to the value of the option.
+// If the option is None, the given default value is returned.
+func MapOr[A any, B any](o Option[A], defaultValue B, f func(A) B) B {
+	if o.present {
+		return f(o.value)
+	} else {
+		return defaultValue
+	}
+}
+
+// MapOrElse returns the result of applying f to the value of the option.
+// If the option is None, the result of calling defaultFunc is returned.
+func MapOrElse[A any, B any](o Option[A], defaultFunc func() B, f func(A) B) B {
+	if o.present {
+		return f(o.value)
+	} else {
+		return defaultFunc()
+	}
+}
+
+// TryMap returns a new option by applying the given fallible function to the value of the option.
+// If f returns an error, None and the error are returned.
+// If the option is None, None and a nil error are returned without calling f.
+func TryMap[A any, B any](o Option[A], f func(A) (B, error)) (Option[B], error) {
+	if !o.present {
+		return None[B](), nil
+	}
+	v, err := f(o.value)
+	if err != nil {
+		return None[B](), err
+	}
+	return New(v), nil
+}
+
+// Apply returns a new option by applying the wrapped function to the wrapped argument.
+// If either of the options is None, None is returned.
+func Apply[A any, B any](of Option[func(A) B], oa Option[A]) Option[B] {
+	if of.present && oa.present {
+		return New(of.value(oa.value))
+	} else {
+		return None[B]()
+	}
+}
+
+// Merge combines the two options.
+// If both options are present, combine decides the result from the two values.
+//
+//	a        b        => result
+//	New(x)   New(y)   => New(combine(x, y))
+//	New(x)   None     => New(x)
+//	None     New(y)   => New(y)
+//	None     None     => None
+func Merge[T any](a, b Option[T], combine func(T, T) T) Option[T] {
+	switch {
+	case a.present && b.present:
+		return New(combine(a.value, b.value))
+	case a.present:
+		return a
+	default:
+		return b
+	}
+}
+
+// ApplyTo calls set with the value of the option if the option is present.
+// If the option is None, ApplyTo does nothing.
+//
+// This is useful to copy optional fields into a configuration struct.
+func ApplyTo[T any](o Option[T], set func(T)) {
+	if o.present {
+		set(o.value)
+	}
+}
+
+// Clone returns a new option with a deep copy of the value of the option.
+// The value is copied by its Clone method if T has a method Clone() T.
+// Otherwise, the option is returned as is, i.e. the value is copied shallowly.
+// If the option is None, None is returned.
+// If the value is a nil pointer, the option is returned as is without calling its Clone method.
+func Clone[T any](o Option[T]) Option[T] {
+	if !o.present || isNilPointer(o.value) {
+		return o
+	}
+	if c, ok := any(o.value).(interface{ Clone() T }); ok {
+		return New(c.Clone())
+	}
+	return o
+}
+
+// CloneFunc returns a new option with a copy of the value of the option made by the given function.
+// If the option is None, None is returned.
+//
+// Use [slices.Clone] or [maps.Clone] to copy an option of slice or map.
+func CloneFunc[T any](o Option[T], f func(T) T) Option[T] {
+	if o.present {
+		return New(f(o.value))
+	} else {
+		return None[T]()
+	}
+}
+
 // String returns the string representation of the wrapped value.
 // If the option is None, an empty string is returned.
 func (o Option[T]) String() string {
@@ -119,7 +649,34 @@ func (o Option[T]) String() string {
 	}
 }
 
+// StringOr returns the string representation of the wrapped value.
+// If the option is None, the given string is returned.
+//
+// Use this method instead of [Option.String] to distinguish None from a present empty string.
+func (o Option[T]) StringOr(noneString string) string {
+	if o.present {
+		return fmt.Sprint(o.value)
+	} else {
+		return noneString
+	}
+}
+
+// MapString returns the string representation of the wrapped value formatted by the given function.
+// If the option is None, an empty string is returned in the same way as [Option.String].
+//
+// Use this method to control formatting of numbers, e.g. with [strconv.FormatFloat].
+func (o Option[T]) MapString(f func(T) string) string {
+	if o.present {
+		return f(o.value)
+	} else {
+		return ""
+	}
+}
+
 // GoString returns the Go representation of the option.
+//
+// The wrapped value is formatted by the %#v verb of the fmt package,
+// which prints maps sorted by key, including nested maps. So the result is deterministic.
 func (o Option[T]) GoString() string {
 	if o.present {
 		return fmt.Sprintf("options.New(%#v)", o.value)
@@ -128,34 +685,272 @@ func (o Option[T]) GoString() string {
 	}
 }
 
+// Format implements the [fmt.Formatter] interface.
+//
+// If the option is present, the wrapped value is formatted with the same verb and flags,
+// e.g. %q quotes a wrapped string and %+v shows field names of a wrapped struct.
+// %s formats a wrapped value that can't be formatted by %s, such as an int, in the same way as %v.
+//
+// If the option is None, %v and %s write an empty string in the same way as [Option.String],
+// and the other verbs write "<none>". Both are padded to the width if it is specified.
+// %#v is formatted by [Option.GoString].
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, o.GoString())
+		return
+	}
+	if !o.present {
+		if verb == 'v' || verb == 's' {
+			writePadded(f, "")
+		} else {
+			writePadded(f, "<none>")
+		}
+		return
+	}
+	if verb == 's' && !formatsAsString(o.value) {
+		fmt.Fprintf(f, fmt.FormatString(f, verb), fmt.Sprint(o.value))
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), o.value)
+}
+
+// formatsAsString reports whether v can be formatted by the %s verb of the fmt package.
+func formatsAsString(v any) bool {
+	switch v.(type) {
+	case fmt.Formatter, fmt.Stringer, error:
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return false
+	}
+	switch rv.Kind() {
+	case reflect.String:
+		return true
+	case reflect.Slice, reflect.Array:
+		return rv.Type().Elem().Kind() == reflect.Uint8
+	default:
+		return false
+	}
+}
+
+// writePadded writes s padded with spaces to the width of f.
+func writePadded(f fmt.State, s string) {
+	width, ok := f.Width()
+	if !ok || width <= len(s) {
+		io.WriteString(f, s)
+		return
+	}
+	padding := strings.Repeat(" ", width-len(s))
+	if f.Flag('-') {
+		io.WriteString(f, s+padding)
+	} else {
+		io.WriteString(f, padding+s)
+	}
+}
+
 // MarshalJSON implements the [json.Marshaler] interface.
+//
+// If the option is None, null is returned.
+// Otherwise, the result is exactly the same as json.Marshal(&v) for the wrapped value v.
+// Note that this is also the same as json.Marshal(v) unless T has a MarshalJSON method with a pointer receiver.
+// As with encoding/json, a struct with only unexported fields is serialized as {} without an error.
 func (o Option[T]) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.Pointer())
 }
 
+// JSONTypeError is returned by [Option.UnmarshalJSON] when the JSON value does not match T,
+// e.g. when a JSON string is unmarshaled into Option[int].
+type JSONTypeError struct {
+	// TypeName is the name of the type T of Option[T].
+	TypeName string
+	// JSON is the JSON value that could not be unmarshaled.
+	JSON string
+	// Err is the underlying error returned by encoding/json.
+	Err error
+}
+
+// maxJSONInError is the maximum length in bytes of the JSON value included in the message of JSONTypeError.
+const maxJSONInError = 64
+
+// Error implements the error interface.
+// The JSON value is truncated in the message if it is longer than 64 bytes.
+func (e *JSONTypeError) Error() string {
+	value := e.JSON
+	if len(value) > maxJSONInError {
+		cut := maxJSONInError
+		for cut > 0 && !utf8.RuneStart(value[cut]) {
+			cut--
+		}
+		value = value[:cut] + "..."
+	}
+	return fmt.Sprintf("Option[%s].UnmarshalJSON: %v (JSON value: %s)", e.TypeName, e.Err, value)
+}
+
+// Unwrap returns the underlying error.
+func (e *JSONTypeError) Unwrap() error {
+	return e.Err
+}
+
 // UnmarshalJSON implements the [json.Unmarshaler] interface.
+// If the JSON value does not match T, [*JSONTypeError] is returned.
+//
+// JSON null is unmarshaled as None even if T is [json.RawMessage],
+// so Option[json.RawMessage] captures the raw JSON value only when it is not null.
+//
+// Use Option[json.Number] to unmarshal numbers without losing precision.
 func (o *Option[T]) UnmarshalJSON(bytes []byte) error {
 	var p *T
 	if err := json.Unmarshal(bytes, &p); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return &JSONTypeError{
+				TypeName: typeName[T](),
+				JSON:     string(bytes),
+				Err:      err,
+			}
+		}
 		return fmt.Errorf("Option[%T].UnmarshalJSON: %w", o.value, err)
 	}
 	*o = FromPointer(p)
 	return nil
 }
 
+// MarshalXML implements the [xml.Marshaler] interface.
+// If the option is None, nothing is written, i.e. the element is omitted.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if o.present {
+		return e.EncodeElement(o.value, start)
+	} else {
+		return nil
+	}
+}
+
+// UnmarshalXML implements the [xml.Unmarshaler] interface.
+// An element with the attribute xsi:nil="true" is unmarshaled as None.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		isXSI := attr.Name.Space == "http://www.w3.org/2001/XMLSchema-instance" || attr.Name.Space == "xsi"
+		if isXSI && attr.Name.Local == "nil" && attr.Value == "true" {
+			*o = None[T]()
+			return d.Skip()
+		}
+	}
+
+	var v T
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return fmt.Errorf("Option[%T].UnmarshalXML: %w", o.value, err)
+	}
+	*o = New(v)
+	return nil
+}
+
+// MarshalXMLAttr implements the [xml.MarshalerAttr] interface.
+// If the option is None, the attribute is omitted.
+//
+// If the option is present, the value is formatted by its MarshalXMLAttr or MarshalText method
+// if T implements [xml.MarshalerAttr] or [encoding.TextMarshaler] respectively,
+// as is if T is []byte, or by [fmt.Sprint] otherwise.
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !o.present {
+		return xml.Attr{}, nil
+	}
+	if m, ok := any(o.value).(xml.MarshalerAttr); ok {
+		attr, err := m.MarshalXMLAttr(name)
+		if err != nil {
+			return xml.Attr{}, fmt.Errorf("Option[%T].MarshalXMLAttr: %w", o.value, err)
+		}
+		return attr, nil
+	}
+	text, err := formatText(o.value)
+	if err != nil {
+		return xml.Attr{}, fmt.Errorf("Option[%T].MarshalXMLAttr: %w", o.value, err)
+	}
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+// UnmarshalXMLAttr implements the [xml.UnmarshalerAttr] interface.
+// An absent attribute leaves the option untouched, so it remains None for a zero value.
+//
+// The attribute value is parsed by the UnmarshalXMLAttr or UnmarshalText method
+// if *T implements [xml.UnmarshalerAttr] or [encoding.TextUnmarshaler] respectively,
+// or converted into T in the same way as [Option.Scan] does for a string otherwise.
+func (o *Option[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var v T
+	var err error
+	if u, ok := any(&v).(xml.UnmarshalerAttr); ok {
+		err = u.UnmarshalXMLAttr(attr)
+	} else {
+		err = parseText(&v, attr.Value)
+	}
+	if err != nil {
+		return fmt.Errorf("Option[%T].UnmarshalXMLAttr: %w", o.value, err)
+	}
+	*o = New(v)
+	return nil
+}
+
 // Value implements the SQL [driver.Valuer] interface.
 // See http://jmoiron.net/blog/built-in-interfaces
+//
+// If T or *T implements [driver.Valuer], the result of its Value method is returned.
+// A present nil pointer is returned as nil, i.e. NULL, without calling its Value method.
+// If the value can't be converted into a [driver.Value] but T implements [encoding.TextMarshaler],
+// such as *[big.Int], the result of its MarshalText method is returned as a string.
 func (o Option[T]) Value() (driver.Value, error) {
-	if o.present {
-		return o.value, nil
-	} else {
+	if !o.present {
+		return nil, nil
+	}
+	if isNilPointer(o.value) {
 		return nil, nil
 	}
+	if valuer, ok := any(o.value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	if valuer, ok := any(&o.value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	if m, ok := any(o.value).(encoding.TextMarshaler); ok {
+		if _, err := driver.DefaultParameterConverter.ConvertValue(o.value); err != nil {
+			text, err := m.MarshalText()
+			if err != nil {
+				return nil, fmt.Errorf("Option[%T].Value: %w", o.value, err)
+			}
+			return string(text), nil
+		}
+	}
+	return o.value, nil
 }
 
 // Scan implements the SQL [driver.Scanner] interface.
 // See http://jmoiron.net/blog/built-in-interfaces
+//
+// src is converted into T in the same way as [sql.Rows.Scan] does.
+// For example, []byte returned by drivers for TEXT columns can be scanned into Option[string],
+// and string can be scanned into Option[[]byte].
+// Bytes scanned into Option[[]byte] are copied, so the option does not share memory with the driver.
+// Numeric values are converted between numeric types, e.g. int64 can be scanned into Option[int32].
+// An error is returned if the value overflows T.
+// Defined types are converted by their underlying types, e.g. int64 can be scanned into Option[time.Duration],
+// and string can be scanned into Option[Status] for an enum type defined as type Status string.
+// Integers 0 and 1, and strings accepted by [strconv.ParseBool] can be scanned into Option[bool];
+// other integers result in an error.
+// If *T implements [sql.Scanner], its Scan method is called with non-nil src.
+// string and []byte are parsed into Option[time.Time] if they are in RFC 3339 or common DATETIME formats.
+// Otherwise, if *T implements [encoding.TextUnmarshaler], strings, []byte and numbers are converted
+// by its UnmarshalText method. This also applies to pointer types such as Option[*big.Int].
+//
+// If src implements [driver.Valuer], such as [sql.NullString], src is replaced with the result of its Value method
+// before the conversion. So invalid sql.Null* values are scanned as None.
 func (o *Option[T]) Scan(src any) error {
+	if valuer, ok := src.(driver.Valuer); ok {
+		v, err := valuer.Value()
+		if err != nil {
+			return fmt.Errorf("Option[%T].Scan: %w", o.value, err)
+		}
+		src = v
+	}
+
 	if src == nil {
 		*o = None[T]()
 		return nil
@@ -171,10 +966,16 @@ func (o *Option[T]) Scan(src any) error {
 }
 
 // Equal returns true if the two options are equal.
-// Equality of the wrapped values is determined by [reflect.DeepEqual].
+// If T has a method Equal(T) bool, such as [time.Time], equality of the wrapped values is determined by it.
+// Otherwise, it is determined by [reflect.DeepEqual].
+// If T is a pointer type and either of the wrapped values is nil, they are compared by == operator
+// without calling the method.
+// As an optimization, values of types without pointers, interfaces, or other reference types,
+// such as int and string, are compared by == operator, which gives the same result.
 //
 // Usually you don't need to call this method since you can use == operator.
 // This method is provided to make Option[T] comparable by [go-cmp].
+// If you need a function for comparable T, use [EqualComparable], which is faster.
 //
 // [go-cmp]: https://github.com/google/go-cmp
 func (o Option[T]) Equal(other Option[T]) bool {
@@ -184,9 +985,60 @@ func (o Option[T]) Equal(other Option[T]) bool {
 	if !o.present {
 		return true
 	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	if t.Kind() == reflect.Pointer && (isNilPointer(o.value) || isNilPointer(other.value)) {
+		return any(o.value) == any(other.value)
+	}
+	if e, ok := any(o.value).(interface{ Equal(T) bool }); ok {
+		return e.Equal(other.value)
+	}
+	if isPlain(t) {
+		return any(o.value) == any(other.value)
+	}
 	return reflect.DeepEqual(o.value, other.value)
 }
 
+// EqualFunc returns true if the two options are equal.
+// Equality of the wrapped values is determined by the given function.
+// Two None options are equal, and a present option is not equal to None.
+// eq is called only if both options are present.
+//
+// This is useful for types like [time.Time] that should be compared by their own Equal method.
+func EqualFunc[T any](a, b Option[T], eq func(T, T) bool) bool {
+	if a.present != b.present {
+		return false
+	}
+	if !a.present {
+		return true
+	}
+	return eq(a.value, b.value)
+}
+
+// EqualComparable returns true if the two options are equal.
+// Equality of the wrapped values is determined by == operator.
+//
+// This function is faster than [Option.Equal] since it does not use reflection.
+// Prefer this function for comparable T in performance-sensitive code.
+func EqualComparable[T comparable](a, b Option[T]) bool {
+	return a.present == b.present && a.value == b.value
+}
+
+// Changed reports whether the option has changed from before to after, and returns after.
+//
+// The transitions are reported as follows:
+//
+//	before     after      changed
+//	None       None       false
+//	None       New(x)     true
+//	New(x)     None       true
+//	New(x)     New(x)     false
+//	New(x)     New(y)     true
+//
+// This is useful to build a JSON merge patch, in which None after a change is encoded as null.
+func Changed[T comparable](before, after Option[T]) (changed bool, newValue Option[T]) {
+	return before != after, after
+}
+
 // Pointer is a free function version of [Option.Pointer].
 //
 // This function is provided to write Transfermer of [go-cmp].
@@ -4,8 +4,14 @@ import (
 	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"math"
+	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -64,6 +70,136 @@ func ExampleFromTuple() {
 	// options.None[int]()
 }
 
+func TestNewOrNone(t *testing.T) {
+	assertEqual(t, options.NewOrNone[int](), options.None[int]())
+	assertEqual(t, options.NewOrNone(42), options.New(42))
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("unexpected panic value: %#v", r)
+		}
+		assertEqual(t, err.Error(), "NewOrNone: too many values: 2")
+	}()
+	options.NewOrNone(1, 2)
+	t.Error("should panic")
+}
+
+func TestDeref(t *testing.T) {
+	v := 42
+	assertEqual(t, options.Deref(options.New(&v)), options.New(42))
+	assertEqual(t, options.Deref(options.New[*int](nil)), options.None[int]())
+	assertEqual(t, options.Deref(options.None[*int]()), options.None[int]())
+}
+
+func TestFlattenPtr(t *testing.T) {
+	some := options.New(42)
+	none := options.None[int]()
+	assertEqual(t, options.FlattenPtr(&some), options.New(42))
+	assertEqual(t, options.FlattenPtr(&none), options.None[int]())
+	assertEqual(t, options.FlattenPtr[int](nil), options.None[int]())
+}
+
+func TestMustFromPointer(t *testing.T) {
+	v := 42
+	assertEqual(t, options.MustFromPointer(&v), options.New(42))
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("unexpected panic value: %#v", r)
+		}
+		assertEqual(t, err.Error(), "MustFromPointer: nil pointer of type *int")
+	}()
+	options.MustFromPointer[int](nil)
+	t.Error("should panic")
+}
+
+func ExampleFromError() {
+	some := options.FromError(strconv.Atoi("42"))
+	fmt.Println(some.GoString())
+
+	none := options.FromError(strconv.Atoi("foo"))
+	fmt.Println(none.GoString())
+
+	// Output:
+	// options.New(42)
+	// options.None[int]()
+}
+
+func TestFromErrorFunc(t *testing.T) {
+	some := options.FromErrorFunc(func() (int, error) { return 42, nil })
+	assertEqual(t, some, options.New(42))
+
+	none := options.FromErrorFunc(func() (int, error) { return 42, errors.New("error") })
+	assertEqual(t, none, options.None[int]())
+}
+
+func TestParseInto(t *testing.T) {
+	opt1, err := options.ParseInto("42", strconv.Atoi)
+	assertEqual(t, err, nil)
+	assertEqual(t, opt1, options.New(42))
+
+	called := false
+	opt2, err := options.ParseInto("", func(s string) (int, error) {
+		called = true
+		return strconv.Atoi(s)
+	})
+	assertEqual(t, err, nil)
+	assertEqual(t, opt2, options.None[int]())
+	assertEqual(t, called, false)
+
+	opt3, err := options.ParseInto("foo", strconv.Atoi)
+	var numErr *strconv.NumError
+	assertEqual(t, errors.As(err, &numErr), true)
+	assertEqual(t, opt3, options.None[int]())
+
+	opt4, err := options.ParseInto("5s", time.ParseDuration)
+	assertEqual(t, err, nil)
+	assertEqual(t, opt4, options.New(5*time.Second))
+}
+
+func TestNonEmpty(t *testing.T) {
+	assertDeepEqual(t, options.NonEmpty([]int{1, 2}), options.New([]int{1, 2}))
+	assertDeepEqual(t, options.NonEmpty([]int{}), options.None[[]int]())
+	assertDeepEqual(t, options.NonEmpty([]int(nil)), options.None[[]int]())
+	assertDeepEqual(t, options.NonEmpty(make([]int, 0, 10)), options.None[[]int]())
+
+	assertEqual(t, options.NonEmptyString("foo"), options.New("foo"))
+	assertEqual(t, options.NonEmptyString(" "), options.New(" "))
+	assertEqual(t, options.NonEmptyString(""), options.None[string]())
+}
+
+func TestFromNillable(t *testing.T) {
+	assertDeepEqual(t, options.FromNillableSlice([]int{1}), options.New([]int{1}))
+	assertDeepEqual(t, options.FromNillableSlice([]int{}), options.New([]int{}))
+	assertDeepEqual(t, options.FromNillableSlice([]int(nil)), options.None[[]int]())
+
+	assertDeepEqual(t, options.FromNillableMap(map[string]int{"a": 1}), options.New(map[string]int{"a": 1}))
+	assertDeepEqual(t, options.FromNillableMap(map[string]int{}), options.New(map[string]int{}))
+	assertDeepEqual(t, options.FromNillableMap(map[string]int(nil)), options.None[map[string]int]())
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan int, 2)
+	assertEqual(t, options.FromChan(ch), options.None[int]())
+
+	ch <- 0
+	ch <- 42
+	assertEqual(t, options.FromChan(ch), options.New(0))
+	assertEqual(t, options.FromChan(ch), options.New(42))
+	assertEqual(t, options.FromChan(ch), options.None[int]())
+
+	ch <- 1
+	close(ch)
+	assertEqual(t, options.FromChan(ch), options.New(1))
+	assertEqual(t, options.FromChan(ch), options.None[int]())
+
+	assertEqual(t, options.FromChan[int](nil), options.None[int]())
+}
+
 func ExampleOption_Unwrap() {
 	opt := options.New(42)
 	fmt.Println(opt.Unwrap())
@@ -71,6 +207,61 @@ func ExampleOption_Unwrap() {
 	// 42
 }
 
+func TestUnwrap_Panic(t *testing.T) {
+	defer func() {
+		r := recover()
+		err, ok := r.(options.UnwrapError)
+		if !ok {
+			t.Fatalf("unexpected panic value: %#v", r)
+		}
+		assertEqual(t, err.TypeName, "int")
+		assertEqual(t, err.Method, "Unwrap")
+		assertEqual(t, err.Caller, "")
+		assertEqual(t, err.Error(), "Option[int].Unwrap: unwrapping None value")
+	}()
+
+	opt := options.None[int]()
+	opt.Unwrap()
+	t.Error("should panic")
+}
+
+func TestUnwrap_PanicInterface(t *testing.T) {
+	defer func() {
+		r := recover()
+		err, ok := r.(options.UnwrapError)
+		if !ok {
+			t.Fatalf("unexpected panic value: %#v", r)
+		}
+		assertEqual(t, err.TypeName, "error")
+		assertEqual(t, err.Error(), "Option[error].Unwrap: unwrapping None value")
+	}()
+
+	var opt options.Option[error]
+	opt.Unwrap()
+	t.Error("should panic")
+}
+
+func TestOrPanic(t *testing.T) {
+	assertEqual(t, options.New(42).OrPanic(), 42)
+
+	defer func() {
+		r := recover()
+		err, ok := r.(options.UnwrapError)
+		if !ok {
+			t.Fatalf("unexpected panic value: %#v", r)
+		}
+		assertEqual(t, err.TypeName, "int")
+		assertEqual(t, err.Method, "OrPanic")
+		if !strings.Contains(err.Caller, "options_test.go:") {
+			t.Errorf("Caller should be the position of the caller: %s", err.Caller)
+		}
+		assertEqual(t, err.Error(), "Option[int].OrPanic: unwrapping None value at "+err.Caller)
+	}()
+
+	options.None[int]().OrPanic()
+	t.Error("should panic")
+}
+
 func ExampleOption_UnwrapOr() {
 	some := options.New(42)
 	fmt.Println(some.UnwrapOr(-1))
@@ -95,6 +286,286 @@ func ExampleOption_UnwrapOrZero() {
 	// 0
 }
 
+func TestUnwrapOrElseErr(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+	called := false
+	fetch := func() (int, error) {
+		called = true
+		return 10, nil
+	}
+
+	v1, err1 := options.New(42).UnwrapOrElseErr(fetch)
+	assertEqual(t, v1, 42)
+	assertEqual(t, err1, nil)
+	assertEqual(t, called, false)
+
+	v2, err2 := options.None[int]().UnwrapOrElseErr(fetch)
+	assertEqual(t, v2, 10)
+	assertEqual(t, err2, nil)
+	assertEqual(t, called, true)
+
+	_, err3 := options.None[int]().UnwrapOrElseErr(func() (int, error) { return 0, errFetch })
+	assertEqual(t, err3, errFetch)
+}
+
+func TestWithDefault(t *testing.T) {
+	assertEqual(t, options.New(42).WithDefault(10), options.New(42))
+	assertEqual(t, options.New(0).WithDefault(10), options.New(0))
+	assertEqual(t, options.None[int]().WithDefault(10), options.New(10))
+	assertEqual(t, options.None[int]().WithDefault(10).String(), "10")
+}
+
+func TestInspectNone(t *testing.T) {
+	misses := 0
+	countMiss := func() { misses++ }
+
+	assertEqual(t, options.New(42).InspectNone(countMiss), options.New(42))
+	assertEqual(t, misses, 0)
+
+	assertEqual(t, options.None[int]().InspectNone(countMiss), options.None[int]())
+	assertEqual(t, misses, 1)
+
+	assertEqual(t, options.None[int]().InspectNone(countMiss).WithDefault(10), options.New(10))
+	assertEqual(t, misses, 2)
+}
+
+func ExampleOption_ForEach() {
+	options.New("alice").ForEach(func(name string) {
+		fmt.Println("hello,", name)
+	})
+	options.None[string]().ForEach(func(name string) {
+		fmt.Println("never called")
+	})
+
+	// Output:
+	// hello, alice
+}
+
+func TestUnwrapOrDefault(t *testing.T) {
+	isPositive := func(v int) bool { return v > 0 }
+
+	assertEqual(t, options.New(42).UnwrapOrDefault(isPositive, 10), 42)
+	assertEqual(t, options.New(-1).UnwrapOrDefault(isPositive, 10), 10)
+	assertEqual(t, options.New(0).UnwrapOrDefault(isPositive, 10), 10)
+
+	called := false
+	v := options.None[int]().UnwrapOrDefault(func(int) bool {
+		called = true
+		return true
+	}, 10)
+	assertEqual(t, v, 10)
+	assertEqual(t, called, false)
+}
+
+func TestUnwrapUnchecked(t *testing.T) {
+	opts := []options.Option[int]{options.New(1), options.None[int](), options.New(2)}
+	sum := 0
+	for _, opt := range opts {
+		if opt.IsPresent() {
+			sum += opt.UnwrapUnchecked()
+		}
+	}
+	assertEqual(t, sum, 3)
+}
+
+func TestSetAndClear(t *testing.T) {
+	var opt options.Option[int]
+
+	opt.Set(42)
+	assertEqual(t, opt, options.New(42))
+
+	opt.Set(0)
+	assertEqual(t, opt, options.New(0))
+
+	opt.Clear()
+	assertEqual(t, opt, options.None[int]())
+
+	opt.Clear()
+	assertEqual(t, opt, options.None[int]())
+}
+
+func TestSetZero(t *testing.T) {
+	opt := options.New(42)
+	opt.SetZero()
+	assertEqual(t, opt.IsPresent(), true)
+	assertEqual(t, opt, options.New(0))
+
+	var none options.Option[string]
+	none.SetZero()
+	assertEqual(t, none, options.New(""))
+	assertEqual(t, marshal(t, none), `""`)
+}
+
+func TestSwap(t *testing.T) {
+	a := options.New(1)
+	b := options.New(2)
+	options.Swap(&a, &b)
+	assertEqual(t, a, options.New(2))
+	assertEqual(t, b, options.New(1))
+
+	c := options.New(3)
+	d := options.None[int]()
+	options.Swap(&c, &d)
+	assertEqual(t, c, options.None[int]())
+	assertEqual(t, d, options.New(3))
+
+	options.Swap(&c, &c)
+	assertEqual(t, c, options.None[int]())
+}
+
+func TestTakeIf(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	opt1 := options.New(42)
+	assertEqual(t, opt1.TakeIf(isEven), options.New(42))
+	assertEqual(t, opt1, options.None[int]())
+
+	opt2 := options.New(43)
+	assertEqual(t, opt2.TakeIf(isEven), options.None[int]())
+	assertEqual(t, opt2, options.New(43))
+
+	called := false
+	opt3 := options.None[int]()
+	taken := opt3.TakeIf(func(int) bool {
+		called = true
+		return true
+	})
+	assertEqual(t, taken, options.None[int]())
+	assertEqual(t, opt3, options.None[int]())
+	assertEqual(t, called, false)
+}
+
+func TestTryUnwrap(t *testing.T) {
+	v1, err1 := options.New(42).TryUnwrap()
+	assertEqual(t, v1, 42)
+	assertEqual(t, err1, nil)
+
+	v2, err2 := options.None[int]().TryUnwrap()
+	assertEqual(t, v2, 0)
+	assertEqual(t, errors.Is(err2, options.ErrNone), true)
+	assertEqual(t, err2.Error(), "Option[int].TryUnwrap: option is None")
+
+	_, err3 := options.None[any]().TryUnwrap()
+	assertEqual(t, err3.Error(), "Option[interface {}].TryUnwrap: option is None")
+}
+
+func TestOkOr(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	v1, err1 := options.New(42).OkOr(errNotFound)
+	assertEqual(t, v1, 42)
+	assertEqual(t, err1, nil)
+
+	v2, err2 := options.None[int]().OkOr(errNotFound)
+	assertEqual(t, v2, 0)
+	assertEqual(t, err2, errNotFound)
+}
+
+func TestOkOrElse(t *testing.T) {
+	errNotFound := errors.New("not found")
+	called := false
+	f := func() error {
+		called = true
+		return errNotFound
+	}
+
+	v1, err1 := options.New(42).OkOrElse(f)
+	assertEqual(t, v1, 42)
+	assertEqual(t, err1, nil)
+	assertEqual(t, called, false)
+
+	v2, err2 := options.None[int]().OkOrElse(f)
+	assertEqual(t, v2, 0)
+	assertEqual(t, err2, errNotFound)
+	assertEqual(t, called, true)
+}
+
+func TestValidate(t *testing.T) {
+	errTooShort := errors.New("too short")
+	errNoAt := errors.New("no @")
+	minLength := func(s string) error {
+		if len(s) < 5 {
+			return errTooShort
+		}
+		return nil
+	}
+	hasAt := func(s string) error {
+		if !strings.Contains(s, "@") {
+			return errNoAt
+		}
+		return nil
+	}
+
+	assertEqual(t, options.New("a@example.com").Validate(true, minLength, hasAt), nil)
+	assertEqual(t, options.New("a@example.com").Validate(false, minLength, hasAt), nil)
+	assertEqual(t, options.None[string]().Validate(true, minLength, hasAt), options.ErrRequired)
+	assertEqual(t, options.None[string]().Validate(false, minLength, hasAt), nil)
+	assertEqual(t, options.New("").Validate(true), nil)
+
+	err := options.New("a@b").Validate(true, minLength, hasAt)
+	assertEqual(t, errors.Is(err, errTooShort), true)
+	assertEqual(t, errors.Is(err, errNoAt), false)
+
+	err = options.New("abc").Validate(false, minLength, hasAt)
+	assertEqual(t, errors.Is(err, errTooShort), true)
+	assertEqual(t, errors.Is(err, errNoAt), true)
+	assertEqual(t, err.Error(), "too short\nno @")
+}
+
+func TestPtrCopy(t *testing.T) {
+	opt := options.New(42)
+
+	p1 := opt.Pointer()
+	*p1 = 1
+	assertEqual(t, opt, options.New(1))
+
+	p2 := opt.PtrCopy()
+	assertEqual(t, *p2, 1)
+	*p2 = 2
+	assertEqual(t, opt, options.New(1))
+	assertEqual(t, opt.PtrCopy() == opt.PtrCopy(), false)
+
+	assertEqual(t, options.None[int]().PtrCopy(), nil)
+}
+
+func TestToAny(t *testing.T) {
+	opts := []options.Option[any]{
+		options.New(42).ToAny(),
+		options.New("hello").ToAny(),
+		options.None[int]().ToAny(),
+	}
+	assertEqual(t, opts[0], options.New[any](42))
+	assertEqual(t, opts[1], options.New[any]("hello"))
+	assertEqual(t, opts[2], options.None[any]())
+}
+
+func TestAsType(t *testing.T) {
+	assertEqual(t, options.AsType[int](options.New(42).ToAny()), options.New(42))
+	assertEqual(t, options.AsType[string](options.New(42).ToAny()), options.None[string]())
+	assertEqual(t, options.AsType[int](options.None[any]()), options.None[int]())
+	assertEqual(t, options.AsType[fmt.Stringer](options.New(time.Second).ToAny()), options.New[fmt.Stringer](time.Second))
+}
+
+func TestTryAsType(t *testing.T) {
+	opt1, err := options.TryAsType[int](options.New(42).ToAny())
+	assertEqual(t, opt1, options.New(42))
+	assertEqual(t, err, nil)
+
+	opt2, err := options.TryAsType[int](options.None[any]())
+	assertEqual(t, opt2, options.None[int]())
+	assertEqual(t, err, nil)
+
+	opt3, err := options.TryAsType[int](options.New("42").ToAny())
+	assertEqual(t, opt3, options.None[int]())
+	assertEqual(t, err.Error(), "TryAsType: string is not int")
+
+	_, err = options.TryAsType[fmt.Stringer](options.New(42).ToAny())
+	assertEqual(t, err.Error(), "TryAsType: int is not fmt.Stringer")
+
+	_, err = options.TryAsType[int](options.New[any](nil))
+	assertEqual(t, err.Error(), "TryAsType: <nil> is not int")
+}
+
 func ExampleMap() {
 	getLength := func(s string) int { return len(s) }
 
@@ -109,6 +580,210 @@ func ExampleMap() {
 	// none: options.None[int]()
 }
 
+func ExampleGetFieldOpt() {
+	type Address struct {
+		City string
+	}
+	type User struct {
+		Name    string
+		Address options.Option[Address]
+	}
+
+	users := []options.Option[User]{
+		options.New(User{Name: "alice", Address: options.New(Address{City: "Tokyo"})}),
+		options.New(User{Name: "bob", Address: options.None[Address]()}),
+		options.None[User](),
+	}
+	for _, user := range users {
+		name := options.GetField(user, func(u User) string { return u.Name })
+		address := options.GetFieldOpt(user, func(u User) options.Option[Address] { return u.Address })
+		city := options.GetField(address, func(a Address) string { return a.City })
+		fmt.Printf("%s: %s\n", name.StringOr("-"), city.StringOr("-"))
+	}
+
+	// Output:
+	// alice: Tokyo
+	// bob: -
+	// -: -
+}
+
+func ExampleCompose2() {
+	parse := func(s string) options.Option[int] {
+		return options.FromError(strconv.Atoi(s))
+	}
+	validatePort := func(v int) options.Option[int] {
+		if v < 1 || v > 65535 {
+			return options.None[int]()
+		}
+		return options.New(v)
+	}
+	parsePort := options.Compose2(parse, validatePort)
+
+	for _, s := range []string{"8080", "foo", "70000"} {
+		fmt.Printf("%s: %s\n", s, parsePort(s).StringOr("<none>"))
+	}
+
+	// Output:
+	// 8080: 8080
+	// foo: <none>
+	// 70000: <none>
+}
+
+func TestCompose2(t *testing.T) {
+	called := false
+	f := func(s string) options.Option[string] { return options.NonEmptyString(s) }
+	g := func(s string) options.Option[int] {
+		called = true
+		return options.New(len(s))
+	}
+	h := options.Compose2(f, g)
+
+	assertEqual(t, h("foo"), options.New(3))
+	assertEqual(t, called, true)
+
+	called = false
+	assertEqual(t, h(""), options.None[int]())
+	assertEqual(t, called, false)
+}
+
+func ExampleMapOr() {
+	some := options.New(42)
+	fmt.Println(options.MapOr(some, "unknown", strconv.Itoa))
+
+	none := options.None[int]()
+	fmt.Println(options.MapOr(none, "unknown", strconv.Itoa))
+
+	// Output:
+	// 42
+	// unknown
+}
+
+func TestMapOrElse(t *testing.T) {
+	called := false
+	defaultFunc := func() string {
+		called = true
+		return "unknown"
+	}
+
+	assertEqual(t, options.MapOrElse(options.New(42), defaultFunc, strconv.Itoa), "42")
+	assertEqual(t, called, false)
+
+	assertEqual(t, options.MapOrElse(options.None[int](), defaultFunc, strconv.Itoa), "unknown")
+	assertEqual(t, called, true)
+}
+
+func TestTryMap(t *testing.T) {
+	opt1, err := options.TryMap(options.New("https://example.com/"), url.Parse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1.Unwrap().Host, "example.com")
+
+	opt2, err := options.TryMap(options.New("foo"), strconv.Atoi)
+	if err == nil {
+		t.Error("should fail")
+	}
+	assertEqual(t, opt2, options.None[int]())
+
+	called := false
+	opt3, err := options.TryMap(options.None[string](), func(s string) (int, error) {
+		called = true
+		return 0, errors.New("error")
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt3, options.None[int]())
+	assertEqual(t, called, false)
+}
+
+func TestApply(t *testing.T) {
+	double := options.New(func(v int) int { return v * 2 })
+	noFunc := options.None[func(int) int]()
+
+	assertEqual(t, options.Apply(double, options.New(21)), options.New(42))
+	assertEqual(t, options.Apply(double, options.None[int]()), options.None[int]())
+	assertEqual(t, options.Apply(noFunc, options.New(21)), options.None[int]())
+	assertEqual(t, options.Apply(noFunc, options.None[int]()), options.None[int]())
+}
+
+func TestMerge(t *testing.T) {
+	preferFirst := func(a, b string) string { return a }
+	concat := func(a, b string) string { return a + b }
+
+	assertEqual(t, options.Merge(options.New("a"), options.New("b"), preferFirst), options.New("a"))
+	assertEqual(t, options.Merge(options.New("a"), options.New("b"), concat), options.New("ab"))
+	assertEqual(t, options.Merge(options.New("a"), options.None[string](), concat), options.New("a"))
+	assertEqual(t, options.Merge(options.None[string](), options.New("b"), concat), options.New("b"))
+	assertEqual(t, options.Merge(options.None[string](), options.None[string](), concat), options.None[string]())
+}
+
+func ExampleApplyTo() {
+	type config struct {
+		Timeout int
+		Retries int
+	}
+	cfg := config{Timeout: 30, Retries: 3}
+
+	timeout := options.New(60)
+	retries := options.None[int]()
+	options.ApplyTo(timeout, func(v int) { cfg.Timeout = v })
+	options.ApplyTo(retries, func(v int) { cfg.Retries = v })
+
+	fmt.Printf("%+v\n", cfg)
+
+	// Output:
+	// {Timeout:60 Retries:3}
+}
+
+type cloneableSlice []int
+
+func (s cloneableSlice) Clone() cloneableSlice {
+	return append(cloneableSlice(nil), s...)
+}
+
+func TestClone(t *testing.T) {
+	orig := options.New(cloneableSlice{1, 2, 3})
+	cloned := options.Clone(orig)
+	cloned.Unwrap()[0] = 100
+	assertDeepEqual(t, orig, options.New(cloneableSlice{1, 2, 3}))
+	assertDeepEqual(t, cloned, options.New(cloneableSlice{100, 2, 3}))
+
+	assertDeepEqual(t, options.Clone(options.None[cloneableSlice]()), options.None[cloneableSlice]())
+}
+
+func (v *version) Clone() *version {
+	c := *v
+	return &c
+}
+
+func TestClone_NilPointer(t *testing.T) {
+	assertEqual(t, options.Clone(options.New[*version](nil)), options.New[*version](nil))
+
+	orig := &version{1, 2}
+	cloned := options.Clone(options.New(orig))
+	assertEqual(t, cloned.Unwrap() != orig, true)
+	assertEqual(t, *cloned.Unwrap(), version{1, 2})
+}
+
+func TestCloneFunc(t *testing.T) {
+	orig := options.New([]string{"foo", "bar"})
+	cloned := options.CloneFunc(orig, func(s []string) []string {
+		return append([]string(nil), s...)
+	})
+	cloned.Unwrap()[0] = "baz"
+	assertDeepEqual(t, orig, options.New([]string{"foo", "bar"}))
+	assertDeepEqual(t, cloned, options.New([]string{"baz", "bar"}))
+
+	called := false
+	none := options.CloneFunc(options.None[[]string](), func(s []string) []string {
+		called = true
+		return s
+	})
+	assertDeepEqual(t, none, options.None[[]string]())
+	assertEqual(t, called, false)
+}
+
 func ExampleOption_String() {
 	some := options.New(true)
 	fmt.Println("some:", some.String())
@@ -121,6 +796,36 @@ func ExampleOption_String() {
 	// none:
 }
 
+func ExampleOption_StringOr() {
+	some := options.New("")
+	fmt.Printf("some: %q\n", some.StringOr("none"))
+
+	none := options.None[string]()
+	fmt.Printf("none: %q\n", none.StringOr("none"))
+
+	// Output:
+	// some: ""
+	// none: "none"
+}
+
+func ExampleOption_MapString() {
+	twoDecimals := func(v float64) string {
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	}
+
+	some := options.New(1.0 / 3)
+	fmt.Printf("String: %q\n", some.String())
+	fmt.Printf("MapString: %q\n", some.MapString(twoDecimals))
+
+	none := options.None[float64]()
+	fmt.Printf("none: %q\n", none.MapString(twoDecimals))
+
+	// Output:
+	// String: "0.3333333333333333"
+	// MapString: "0.33"
+	// none: ""
+}
+
 func ExampleOption_GoString() {
 	some := options.New(true)
 	fmt.Printf("some: %#v\n", some)
@@ -133,6 +838,53 @@ func ExampleOption_GoString() {
 	// none: options.None[bool]()
 }
 
+func TestGoString_Map(t *testing.T) {
+	opt := options.New(map[string]map[int]bool{
+		"foo": {3: true, 1: false, 2: true},
+		"bar": {9: false, -1: true},
+		"baz": nil,
+	})
+	expected := `options.New(map[string]map[int]bool{"bar":map[int]bool{-1:true, 9:false}, "baz":map[int]bool(nil), "foo":map[int]bool{1:false, 2:true, 3:true}})`
+	for i := 0; i < 100; i++ {
+		assertEqual(t, opt.GoString(), expected)
+	}
+}
+
+func ExampleOption_Format() {
+	type point struct{ X, Y int }
+
+	fmt.Printf("%q\n", options.New("hello"))
+	fmt.Printf("%+v\n", options.New(point{X: 1, Y: 2}))
+	fmt.Printf("[%5d]\n", options.New(42))
+	fmt.Printf("%.2f\n", options.New(3.14159))
+	fmt.Printf("%q\n", options.None[string]())
+
+	// Output:
+	// "hello"
+	// {X:1 Y:2}
+	// [   42]
+	// 3.14
+	// <none>
+}
+
+func TestFormat(t *testing.T) {
+	assertEqual(t, fmt.Sprint(options.New(42)), "42")
+	assertEqual(t, fmt.Sprint(options.None[int]()), "")
+	assertEqual(t, fmt.Sprint(options.None[int]()), options.None[int]().String())
+	assertEqual(t, fmt.Sprintf("%q", options.None[string]()), "<none>")
+	assertEqual(t, fmt.Sprintf("%10v|", options.None[int]()), "          |")
+	assertEqual(t, fmt.Sprintf("%-8d|", options.None[int]()), "<none>  |")
+	assertEqual(t, fmt.Sprintf("%s", options.New(42)), "42")
+	assertEqual(t, fmt.Sprintf("%4s|", options.New(42)), "  42|")
+	assertEqual(t, fmt.Errorf("val=%s", options.New(3.5)).Error(), "val=3.5")
+	assertEqual(t, fmt.Sprintf("%s", options.New([]byte("ab"))), "ab")
+	assertEqual(t, fmt.Sprintf("%s", options.New(time.Duration(1500)*time.Millisecond)), "1.5s")
+	assertEqual(t, fmt.Sprintf("%x", options.New(255)), "ff")
+	assertEqual(t, fmt.Sprintf("%-4s|", options.New("a")), "a   |")
+	assertEqual(t, fmt.Sprintf("%#v", options.New("a")), `options.New("a")`)
+	assertEqual(t, fmt.Sprintf("%#v", options.None[string]()), "options.None[string]()")
+}
+
 func TestJSONMarshal(t *testing.T) {
 	opt1 := options.New(3.14)
 	assertEqual(t, marshal(t, opt1), `3.14`)
@@ -157,6 +909,42 @@ func TestJSONMarshal(t *testing.T) {
 	assertEqual(t, marshal(t, opt6), `{"bar":2,"foo":1}`)
 }
 
+type unexportedOnly struct {
+	name string
+	age  int
+}
+
+type taggedStruct struct {
+	Name    string            `json:"name"`
+	Age     int               `json:"age,omitempty"`
+	Secret  string            `json:"-"`
+	Nested  *taggedStruct     `json:"nested,omitempty"`
+	Labels  map[string]string `json:"labels"`
+	private int
+}
+
+type embeddingStruct struct {
+	taggedStruct
+	Extra float64 `json:",string"`
+}
+
+func assertJSONParity[T any](t *testing.T, v T) {
+	t.Helper()
+	assertEqual(t, marshal(t, options.New(v)), marshal(t, v))
+}
+
+func TestJSONMarshal_Parity(t *testing.T) {
+	assertJSONParity(t, unexportedOnly{name: "alice", age: 20})
+	assertJSONParity(t, taggedStruct{Name: "alice", Secret: "s", Labels: map[string]string{"b": "2", "a": "1"}, private: 1})
+	assertJSONParity(t, taggedStruct{Name: "bob", Age: 30, Nested: &taggedStruct{Name: "carol"}})
+	assertJSONParity(t, embeddingStruct{taggedStruct: taggedStruct{Name: "dave"}, Extra: 1.5})
+	assertJSONParity(t, struct{}{})
+	assertJSONParity(t, []taggedStruct{{Name: "eve"}})
+	assertJSONParity(t, time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC))
+
+	assertEqual(t, marshal(t, options.New(unexportedOnly{name: "alice"})), `{}`)
+}
+
 func TestJSONUnmarshal(t *testing.T) {
 	json1 := `3.14`
 	opt1 := unmarshal[options.Option[float64]](t, json1)
@@ -187,6 +975,100 @@ func TestJSONUnmarshal(t *testing.T) {
 	assertDeepEqual(t, *opt6, options.New(map[string]int{"foo": 1, "bar": 2}))
 }
 
+func TestJSON_RawMessage(t *testing.T) {
+	type payload struct {
+		ID   int                             `json:"id"`
+		Data options.Option[json.RawMessage] `json:"data"`
+	}
+
+	raw := `{"nested":{"list":[1,2,{"deep":true}],"str":"x"}}`
+	p1 := payload{ID: 1, Data: options.New(json.RawMessage(raw))}
+	assertEqual(t, marshal(t, p1), `{"id":1,"data":`+raw+`}`)
+
+	p2 := payload{ID: 2, Data: options.None[json.RawMessage]()}
+	assertEqual(t, marshal(t, p2), `{"id":2,"data":null}`)
+
+	u1 := unmarshal[payload](t, `{"id":1,"data":`+raw+`}`)
+	assertEqual(t, string(u1.Data.Unwrap()), raw)
+
+	u2 := unmarshal[payload](t, `{"id":2,"data":null}`)
+	assertDeepEqual(t, u2.Data, options.None[json.RawMessage]())
+
+	u3 := unmarshal[payload](t, `{"id":3}`)
+	assertDeepEqual(t, u3.Data, options.None[json.RawMessage]())
+
+	u4 := unmarshal[payload](t, `{"id":4,"data":[]}`)
+	assertEqual(t, string(u4.Data.Unwrap()), `[]`)
+}
+
+func TestJSON_Number(t *testing.T) {
+	type payload struct {
+		ID options.Option[json.Number] `json:"id"`
+	}
+
+	const big = "12345678901234567890"
+	p1 := unmarshal[payload](t, `{"id":`+big+`}`)
+	assertEqual(t, p1.ID, options.New(json.Number(big)))
+	assertEqual(t, marshal(t, p1), `{"id":`+big+`}`)
+
+	// float64 loses precision of the same number.
+	p2 := unmarshal[options.Option[float64]](t, big)
+	if strconv.FormatFloat(p2.Unwrap(), 'f', -1, 64) == big {
+		t.Error("float64 should not preserve 20 digits")
+	}
+
+	p3 := unmarshal[payload](t, `{"id":null}`)
+	assertEqual(t, p3.ID, options.None[json.Number]())
+	assertEqual(t, marshal(t, p3), `{"id":null}`)
+}
+
+func TestJSONUnmarshal_TypeError(t *testing.T) {
+	var opt options.Option[int]
+	err := json.Unmarshal([]byte(`"42"`), &opt)
+
+	var typeErr *options.JSONTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	assertEqual(t, typeErr.TypeName, "int")
+	assertEqual(t, typeErr.JSON, `"42"`)
+	assertEqual(t, err.Error(), `Option[int].UnmarshalJSON: json: cannot unmarshal string into Go value of type int (JSON value: "42")`)
+
+	var jsonErr *json.UnmarshalTypeError
+	if !errors.As(err, &jsonErr) {
+		t.Errorf("should wrap json.UnmarshalTypeError: %#v", err)
+	}
+
+	err = json.Unmarshal([]byte(`{"foo": 1, "bar": "2"}`), &struct {
+		Foo options.Option[int] `json:"foo"`
+		Bar options.Option[int] `json:"bar"`
+	}{})
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	assertEqual(t, typeErr.JSON, `"2"`)
+
+	err = opt.UnmarshalJSON([]byte(`{`))
+	if err == nil || errors.As(err, &typeErr) {
+		t.Errorf("syntax error should not be JSONTypeError: %#v", err)
+	}
+
+	var stringer options.Option[fmt.Stringer]
+	err = json.Unmarshal([]byte(`"foo"`), &stringer)
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	assertEqual(t, typeErr.TypeName, "fmt.Stringer")
+
+	long := `"` + strings.Repeat("あ", 30) + `"`
+	err = json.Unmarshal([]byte(long), &opt)
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("unexpected error: %#v", err)
+	}
+	assertEqual(t, typeErr.JSON, long)
+	assertEqual(t, strings.HasSuffix(err.Error(), `(JSON value: "`+strings.Repeat("あ", 21)+`...)`), true)
+}
+
 func TestSQLValue(t *testing.T) {
 	opt1 := options.New(3.14)
 	value1 := toSQLValue(t, opt1)
@@ -210,6 +1092,144 @@ func TestSQLValue(t *testing.T) {
 	assertEqual[any](t, value5, nil)
 }
 
+type xmlPerson struct {
+	XMLName xml.Name               `xml:"person"`
+	Name    string                 `xml:"name"`
+	Email   options.Option[string] `xml:"email"`
+}
+
+type xmlItem struct {
+	XMLName xml.Name                  `xml:"item"`
+	ID      options.Option[int]       `xml:"id,attr"`
+	Label   options.Option[string]    `xml:"label,attr,omitempty"`
+	Updated options.Option[time.Time] `xml:"updated,attr"`
+}
+
+func TestXMLMarshal(t *testing.T) {
+	p1 := xmlPerson{Name: "alice", Email: options.New("alice@example.com")}
+	x1, err := xml.Marshal(p1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, string(x1), `<person><name>alice</name><email>alice@example.com</email></person>`)
+
+	p2 := xmlPerson{Name: "bob", Email: options.None[string]()}
+	x2, err := xml.Marshal(p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, string(x2), `<person><name>bob</name></person>`)
+
+	p3 := xmlPerson{Name: "carol", Email: options.New("")}
+	x3, err := xml.Marshal(p3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, string(x3), `<person><name>carol</name><email></email></person>`)
+
+	i1 := xmlItem{
+		ID:      options.New(3),
+		Label:   options.New(""),
+		Updated: options.New(time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)),
+	}
+	x4, err := xml.Marshal(i1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, string(x4), `<item id="3" label="" updated="2021-02-03T04:05:06Z"></item>`)
+
+	x5, err := xml.Marshal(xmlItem{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, string(x5), `<item></item>`)
+}
+
+func TestXMLUnmarshal(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected options.Option[string]
+	}{
+		{`<person><name>alice</name><email>alice@example.com</email></person>`, options.New("alice@example.com")},
+		{`<person><name>alice</name><email></email></person>`, options.New("")},
+		{`<person><name>alice</name></person>`, options.None[string]()},
+		{`<person xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><name>alice</name><email xsi:nil="true"/></person>`, options.None[string]()},
+	}
+	for _, tc := range testCases {
+		var p xmlPerson
+		if err := xml.Unmarshal([]byte(tc.input), &p); err != nil {
+			t.Fatal(err)
+		}
+		assertEqual(t, p.Name, "alice")
+		assertEqual(t, p.Email, tc.expected)
+	}
+
+	var opt options.Option[int]
+	err := xml.Unmarshal([]byte(`<num>foo</num>`), &opt)
+	if err == nil {
+		t.Error("should fail")
+	}
+
+	var i1 xmlItem
+	if err := xml.Unmarshal([]byte(`<item id="3" label="" updated="2021-02-03T04:05:06Z"/>`), &i1); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, i1.ID, options.New(3))
+	assertEqual(t, i1.Label, options.New(""))
+	assertEqual(t, i1.Updated, options.New(time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)))
+
+	var i2 xmlItem
+	if err := xml.Unmarshal([]byte(`<item/>`), &i2); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, i2.ID, options.None[int]())
+	assertEqual(t, i2.Label, options.None[string]())
+	assertEqual(t, i2.Updated, options.None[time.Time]())
+
+	var i3 xmlItem
+	err = xml.Unmarshal([]byte(`<item id="foo"/>`), &i3)
+	if err == nil {
+		t.Error("should fail")
+	}
+}
+
+type point struct {
+	X, Y int
+}
+
+func (p *point) Value() (driver.Value, error) {
+	return fmt.Sprintf("(%d,%d)", p.X, p.Y), nil
+}
+
+type celsius float64
+
+func (c celsius) Value() (driver.Value, error) {
+	return fmt.Sprintf("%.1fC", float64(c)), nil
+}
+
+func TestSQLValue_Valuer(t *testing.T) {
+	opt1 := options.New(point{X: 1, Y: 2})
+	value1 := toSQLValue(t, opt1)
+	assertEqual[any](t, value1, "(1,2)")
+
+	opt2 := options.None[point]()
+	value2 := toSQLValue(t, opt2)
+	assertEqual[any](t, value2, nil)
+
+	opt3 := options.New(celsius(36.5))
+	value3 := toSQLValue(t, opt3)
+	assertEqual[any](t, value3, "36.5C")
+
+	opt4 := options.New[*celsius](nil)
+	value4 := toSQLValue(t, opt4)
+	assertEqual[any](t, value4, nil)
+
+	c := celsius(20)
+	opt5 := options.New(&c)
+	value5 := toSQLValue(t, opt5)
+	assertEqual[any](t, value5, "20.0C")
+}
+
 func TestSQLScan(t *testing.T) {
 	nullString1, _ := sql.NullString{String: "hello", Valid: true}.Value()
 	var opt1 options.Option[string]
@@ -253,6 +1273,246 @@ func TestSQLScan(t *testing.T) {
 	assertEqual(t, opt6, options.None[string]())
 }
 
+func TestSQLScan_Bytes(t *testing.T) {
+	src := []byte("hello")
+	var opt1 options.Option[string]
+	if err := opt1.Scan(src); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1, options.New("hello"))
+
+	var opt2 options.Option[string]
+	if err := opt2.Scan([]byte{}); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2, options.New(""))
+
+	var opt3 options.Option[[]byte]
+	if err := opt3.Scan(src); err != nil {
+		t.Fatal(err)
+	}
+	assertDeepEqual(t, opt3, options.New([]byte("hello")))
+
+	var opt4 options.Option[[]byte]
+	if err := opt4.Scan(""); err != nil {
+		t.Fatal(err)
+	}
+	assertDeepEqual(t, opt4, options.New([]byte{}))
+
+	// The scanned option owns a copy of the bytes, so reuse of the buffer by the driver does not affect it.
+	buf := []byte("hello")
+	var opt5 options.Option[[]byte]
+	if err := opt5.Scan(buf); err != nil {
+		t.Fatal(err)
+	}
+	copy(buf, "world")
+	assertDeepEqual(t, opt5, options.New([]byte("hello")))
+}
+
+func TestSQLScan_Numeric(t *testing.T) {
+	var opt1 options.Option[int]
+	if err := opt1.Scan(int64(42)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1, options.New(42))
+
+	var opt2 options.Option[int32]
+	if err := opt2.Scan(int64(-42)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2, options.New[int32](-42))
+
+	var opt3 options.Option[uint64]
+	if err := opt3.Scan(int64(42)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt3, options.New[uint64](42))
+
+	var opt4 options.Option[float32]
+	if err := opt4.Scan(float64(1.5)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt4, options.New[float32](1.5))
+
+	var opt5 options.Option[int32]
+	err := opt5.Scan(int64(math.MaxInt32 + 1))
+	assertEqual(t, err.Error(), `Option[int32].Scan: converting driver.Value type int64 ("2147483648") to a int32: value out of range`)
+	assertEqual(t, opt5, options.None[int32]())
+
+	var opt6 options.Option[uint64]
+	err = opt6.Scan(int64(-1))
+	assertEqual(t, err.Error(), `Option[uint64].Scan: converting driver.Value type int64 ("-1") to a uint64: invalid syntax`)
+	assertEqual(t, opt6, options.None[uint64]())
+}
+
+func TestSQLScan_Bool(t *testing.T) {
+	testCases := []struct {
+		src      any
+		expected bool
+	}{
+		{int64(1), true},
+		{int64(0), false},
+		{[]byte("1"), true},
+		{[]byte("0"), false},
+		{[]byte("true"), true},
+		{[]byte("false"), false},
+		{"true", true},
+		{true, true},
+	}
+	for _, tc := range testCases {
+		var opt options.Option[bool]
+		if err := opt.Scan(tc.src); err != nil {
+			t.Errorf("%#v: %v", tc.src, err)
+			continue
+		}
+		assertEqual(t, opt, options.New(tc.expected))
+	}
+
+	var opt options.Option[bool]
+	err := opt.Scan(int64(2))
+	assertEqual(t, err.Error(), `Option[bool].Scan: sql/driver: couldn't convert 2 into type bool`)
+	assertEqual(t, opt, options.None[bool]())
+}
+
+type status string
+
+type priority int8
+
+func TestSQLScan_Enum(t *testing.T) {
+	var opt1 options.Option[status]
+	if err := opt1.Scan("active"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1, options.New(status("active")))
+
+	var opt2 options.Option[status]
+	if err := opt2.Scan([]byte("inactive")); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2, options.New(status("inactive")))
+
+	var opt3 options.Option[priority]
+	if err := opt3.Scan(int64(3)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt3, options.New(priority(3)))
+
+	var opt4 options.Option[priority]
+	if err := opt4.Scan([]byte("-1")); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt4, options.New(priority(-1)))
+
+	var opt5 options.Option[priority]
+	err := opt5.Scan(int64(128))
+	assertEqual(t, err.Error(), `Option[options_test.priority].Scan: converting driver.Value type int64 ("128") to a int8: value out of range`)
+	assertEqual(t, opt5, options.None[priority]())
+}
+
+func TestSQLScan_Duration(t *testing.T) {
+	var opt1 options.Option[time.Duration]
+	if err := opt1.Scan(int64(5000000000)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1, options.New(5*time.Second))
+
+	var opt2 options.Option[time.Duration]
+	if err := opt2.Scan([]byte("1000")); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2, options.New(time.Microsecond))
+}
+
+func TestSQLScan_TimeString(t *testing.T) {
+	var opt1 options.Option[time.Time]
+	if err := opt1.Scan("2021-02-03T04:05:06Z"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1, options.New(time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)))
+
+	var opt2 options.Option[time.Time]
+	if err := opt2.Scan([]byte("2021-02-03 04:05:06.789")); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2, options.New(time.Date(2021, 2, 3, 4, 5, 6, 789000000, time.UTC)))
+
+	var opt3 options.Option[time.Time]
+	if err := opt3.Scan("not a time"); err == nil {
+		t.Error("should fail")
+	}
+	assertEqual(t, opt3, options.None[time.Time]())
+}
+
+type color int
+
+const (
+	colorRed color = iota + 1
+	colorBlue
+)
+
+func (c *color) Scan(src any) error {
+	switch src {
+	case "red":
+		*c = colorRed
+	case "blue":
+		*c = colorBlue
+	default:
+		return fmt.Errorf("unknown color: %v", src)
+	}
+	return nil
+}
+
+func TestSQLScan_Scanner(t *testing.T) {
+	var opt1 options.Option[color]
+	if err := opt1.Scan("blue"); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1, options.New(colorBlue))
+
+	var opt2 options.Option[color]
+	if err := opt2.Scan(nil); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2, options.None[color]())
+
+	var opt3 options.Option[color]
+	err := opt3.Scan("green")
+	assertEqual(t, err.Error(), "Option[options_test.color].Scan: unknown color: green")
+	assertEqual(t, opt3, options.None[color]())
+}
+
+func TestSQLScan_Valuer(t *testing.T) {
+	var opt1 options.Option[string]
+	if err := opt1.Scan(sql.NullString{String: "hello", Valid: true}); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt1, options.New("hello"))
+
+	opt2 := options.New("hello")
+	if err := opt2.Scan(sql.NullString{}); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt2, options.None[string]())
+
+	var opt3 options.Option[int32]
+	if err := opt3.Scan(sql.NullInt64{Int64: 42, Valid: true}); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt3, options.New[int32](42))
+
+	var opt4 options.Option[string]
+	if err := opt4.Scan(celsius(36.5)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt4, options.New("36.5C"))
+
+	var opt5 options.Option[int]
+	if err := opt5.Scan(options.New[int64](42)); err != nil {
+		t.Fatal(err)
+	}
+	assertEqual(t, opt5, options.New(42))
+}
+
 func TestEqual(t *testing.T) {
 	assertEqual(t, options.New(3.14).Equal(options.New(3.14)), true)
 	assertEqual(t, options.New(3.14).Equal(options.New(1.59)), false)
@@ -261,3 +1521,129 @@ func TestEqual(t *testing.T) {
 	assertEqual(t, options.None[float64]().Equal(options.New(3.14)), false)
 	assertEqual(t, options.New("hello").Equal(options.New("hello")), true)
 }
+
+func TestEqual_EqualMethod(t *testing.T) {
+	// now has a monotonic clock reading, and stripped does not.
+	now := time.Now()
+	stripped := now.Round(0)
+	assertEqual(t, reflect.DeepEqual(now, stripped), false)
+	assertEqual(t, options.New(now).Equal(options.New(stripped)), true)
+
+	// The same instant in different locations.
+	utc := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	jst := utc.In(time.FixedZone("JST", 9*60*60))
+	assertEqual(t, options.New(utc).Equal(options.New(jst)), true)
+	assertEqual(t, options.New(utc).Equal(options.New(utc.Add(time.Second))), false)
+	assertEqual(t, options.New(utc).Equal(options.None[time.Time]()), false)
+}
+
+type version struct {
+	major, minor int
+}
+
+func (v *version) Equal(other *version) bool {
+	return v.major == other.major && v.minor == other.minor
+}
+
+func TestEqual_NilPointer(t *testing.T) {
+	nilVersion := options.New[*version](nil)
+	assertEqual(t, nilVersion.Equal(options.New[*version](nil)), true)
+	assertEqual(t, nilVersion.Equal(options.New(&version{1, 2})), false)
+	assertEqual(t, options.New(&version{1, 2}).Equal(nilVersion), false)
+	assertEqual(t, options.New(&version{1, 2}).Equal(options.New(&version{1, 2})), true)
+	assertEqual(t, options.New(&version{1, 2}).Equal(options.New(&version{1, 3})), false)
+}
+
+func TestEqual_DeepEqualSemantics(t *testing.T) {
+	type plain struct {
+		ID   int
+		Tags [2]string
+	}
+	assertEqual(t, options.New(plain{1, [2]string{"a", "b"}}).Equal(options.New(plain{1, [2]string{"a", "b"}})), true)
+	assertEqual(t, options.New(plain{1, [2]string{"a", "b"}}).Equal(options.New(plain{1, [2]string{"a", "c"}})), false)
+	assertEqual(t, options.New(math.NaN()).Equal(options.New(math.NaN())), false)
+
+	// Pointers and interfaces are compared deeply, unlike == operator.
+	type withPointer struct {
+		Value *int
+	}
+	v1, v2 := 42, 42
+	assertEqual(t, options.New(withPointer{&v1}).Equal(options.New(withPointer{&v2})), true)
+	assertEqual(t, options.New[any]([]int{1}).Equal(options.New[any]([]int{1})), true)
+	assertEqual(t, options.New[any](1).Equal(options.New[any](int64(1))), false)
+}
+
+func benchmarkEqual[T any](b *testing.B, v1, v2 T) {
+	o1 := options.New(v1)
+	o2 := options.New(v2)
+
+	b.Run("Equal", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			o1.Equal(o2)
+		}
+	})
+
+	// The baseline comparing the values by reflect.DeepEqual as Equal did before the fast path.
+	b.Run("DeepEqual", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			reflect.DeepEqual(o1.UnwrapUnchecked(), o2.UnwrapUnchecked())
+		}
+	})
+}
+
+func BenchmarkEqual(b *testing.B) {
+	type plain struct {
+		ID   int
+		Name string
+	}
+
+	b.Run("int", func(b *testing.B) { benchmarkEqual(b, 42, 42) })
+	b.Run("string", func(b *testing.B) { benchmarkEqual(b, "hello, world", "hello, world") })
+	b.Run("struct", func(b *testing.B) { benchmarkEqual(b, plain{1, "foo"}, plain{1, "foo"}) })
+}
+
+func TestEqualComparable(t *testing.T) {
+	assertEqual(t, options.EqualComparable(options.New(3.14), options.New(3.14)), true)
+	assertEqual(t, options.EqualComparable(options.New(3.14), options.New(1.59)), false)
+	assertEqual(t, options.EqualComparable(options.New(0.0), options.None[float64]()), false)
+	assertEqual(t, options.EqualComparable(options.None[float64](), options.None[float64]()), true)
+	assertEqual(t, options.EqualComparable(options.None[float64](), options.New(0.0)), false)
+	assertEqual(t, options.EqualComparable(options.New("hello"), options.New("hello")), true)
+}
+
+func TestChanged(t *testing.T) {
+	testCases := []struct {
+		before, after options.Option[int]
+		changed       bool
+	}{
+		{options.None[int](), options.None[int](), false},
+		{options.None[int](), options.New(1), true},
+		{options.None[int](), options.New(0), true},
+		{options.New(1), options.None[int](), true},
+		{options.New(1), options.New(1), false},
+		{options.New(1), options.New(2), true},
+	}
+	for _, tc := range testCases {
+		changed, newValue := options.Changed(tc.before, tc.after)
+		assertEqual(t, changed, tc.changed)
+		assertEqual(t, newValue, tc.after)
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	ts := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	timeEqual := func(a, b time.Time) bool { return a.Equal(b) }
+
+	assertEqual(t, options.EqualFunc(options.New(ts), options.New(ts.In(time.FixedZone("JST", 9*3600))), timeEqual), true)
+	assertEqual(t, options.EqualFunc(options.New(ts), options.New(ts.Add(time.Second)), timeEqual), false)
+	assertEqual(t, options.EqualFunc(options.New(ts), options.None[time.Time](), timeEqual), false)
+	assertEqual(t, options.EqualFunc(options.None[time.Time](), options.New(ts), timeEqual), false)
+
+	called := false
+	eq := options.EqualFunc(options.None[time.Time](), options.None[time.Time](), func(a, b time.Time) bool {
+		called = true
+		return false
+	})
+	assertEqual(t, eq, true)
+	assertEqual(t, called, false)
+}
@@ -0,0 +1,19 @@
+// Package optiontest provides helpers to test code using options.
+package optiontest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+// MustUnwrap returns the value of the option.
+// If the option is None, MustUnwrap reports a fatal error to t, which stops the test.
+func MustUnwrap[T any](t testing.TB, o options.Option[T]) T {
+	t.Helper()
+	if o.IsNone() {
+		t.Fatalf("expected a present Option[%s], but got None", reflect.TypeOf((*T)(nil)).Elem())
+	}
+	return o.UnwrapOrZero()
+}
@@ -0,0 +1,60 @@
+package optiontest_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/cybozu-go/options"
+	"github.com/cybozu-go/options/optiontest"
+)
+
+// fakeT records a fatal error instead of failing the test.
+type fakeT struct {
+	testing.TB
+	fatal string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.fatal = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+// run calls f with a fakeT in a new goroutine, so that Fatalf can stop it.
+func run(f func(t testing.TB)) *fakeT {
+	ft := &fakeT{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f(ft)
+	}()
+	<-done
+	return ft
+}
+
+func TestMustUnwrap(t *testing.T) {
+	if v := optiontest.MustUnwrap(t, options.New(42)); v != 42 {
+		t.Errorf("unexpected value: %d", v)
+	}
+
+	reached := false
+	ft := run(func(t testing.TB) {
+		optiontest.MustUnwrap(t, options.None[int]())
+		reached = true
+	})
+	if reached {
+		t.Error("MustUnwrap should stop the test for None")
+	}
+	if ft.fatal != "expected a present Option[int], but got None" {
+		t.Errorf("unexpected message: %s", ft.fatal)
+	}
+
+	ft = run(func(t testing.TB) {
+		optiontest.MustUnwrap(t, options.None[error]())
+	})
+	if ft.fatal != "expected a present Option[error], but got None" {
+		t.Errorf("unexpected message: %s", ft.fatal)
+	}
+}
@@ -0,0 +1,31 @@
+//go:build go1.21
+
+package options
+
+import "cmp"
+
+// Max returns the maximum value among the present values of the given options.
+// None elements are ignored. If no option is present, None is returned.
+// If several values are the maximum, the first one is returned.
+func Max[T cmp.Ordered](opts ...Option[T]) Option[T] {
+	var result Option[T]
+	for _, o := range opts {
+		if o.present && (!result.present || cmp.Less(result.value, o.value)) {
+			result = o
+		}
+	}
+	return result
+}
+
+// Min returns the minimum value among the present values of the given options.
+// None elements are ignored. If no option is present, None is returned.
+// If several values are the minimum, the first one is returned.
+func Min[T cmp.Ordered](opts ...Option[T]) Option[T] {
+	var result Option[T]
+	for _, o := range opts {
+		if o.present && (!result.present || cmp.Less(o.value, result.value)) {
+			result = o
+		}
+	}
+	return result
+}
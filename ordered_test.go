@@ -0,0 +1,33 @@
+//go:build go1.21
+
+package options_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestMaxMin(t *testing.T) {
+	readings := []options.Option[float64]{
+		options.None[float64](),
+		options.New(20.5),
+		options.New(-3.0),
+		options.None[float64](),
+		options.New(18.0),
+	}
+	assertEqual(t, options.Max(readings...), options.New(20.5))
+	assertEqual(t, options.Min(readings...), options.New(-3.0))
+
+	assertEqual(t, options.Max(options.None[int](), options.None[int]()), options.None[int]())
+	assertEqual(t, options.Min(options.None[int](), options.None[int]()), options.None[int]())
+	assertEqual(t, options.Max[int](), options.None[int]())
+	assertEqual(t, options.Min[int](), options.None[int]())
+
+	// NaN is less than any other value, as cmp.Less defines.
+	nan := options.New(math.NaN())
+	assertEqual(t, options.Max(nan, options.New(1.0)), options.New(1.0))
+	lowest := options.Min(options.New(1.0), nan)
+	assertEqual(t, math.IsNaN(lowest.Unwrap()), true)
+}
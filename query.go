@@ -0,0 +1,44 @@
+package options
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// EncodeQuery adds the value of the option to v with the given key.
+// If the option is None, v is not modified.
+//
+// The value is formatted by its MarshalText method if T implements [encoding.TextMarshaler],
+// as is if T is []byte, or by [fmt.Sprint] otherwise, so that it can be parsed back by [FromQuery].
+// If MarshalText returns an error, v is not modified and the error is returned.
+func (o Option[T]) EncodeQuery(key string, v url.Values) error {
+	if !o.present {
+		return nil
+	}
+	text, err := formatText(o.value)
+	if err != nil {
+		return fmt.Errorf("Option[%T].EncodeQuery: %w", o.value, err)
+	}
+	v.Add(key, text)
+	return nil
+}
+
+// FromQuery creates Option[T] from the first value for the given key of v.
+// If the key is absent, None and a nil error are returned.
+// An empty value is not treated as None, e.g. "key=" results in a present empty string for Option[string].
+//
+// The value is parsed by its UnmarshalText method if *T implements [encoding.TextUnmarshaler],
+// or converted into T in the same way as [Option.Scan] does for a string otherwise.
+// If the conversion fails, None and an error are returned.
+func FromQuery[T any](v url.Values, key string) (Option[T], error) {
+	values := v[key]
+	if len(values) == 0 {
+		return None[T](), nil
+	}
+
+	var value T
+	if err := parseText(&value, values[0]); err != nil {
+		return None[T](), fmt.Errorf("FromQuery[%T]: %w", value, err)
+	}
+	return New(value), nil
+}
@@ -0,0 +1,68 @@
+package options_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestEncodeQuery(t *testing.T) {
+	v := url.Values{}
+	options.New("alice").EncodeQuery("name", v)
+	options.New(20).EncodeQuery("age", v)
+	options.New("").EncodeQuery("nickname", v)
+	options.None[string]().EncodeQuery("email", v)
+	assertEqual(t, v.Encode(), "age=20&name=alice&nickname=")
+}
+
+func TestEncodeQuery_RoundTrip(t *testing.T) {
+	ts := time.Date(2021, 1, 2, 3, 4, 5, 0, time.UTC)
+	v := url.Values{}
+	assertEqual(t, options.New(ts).EncodeQuery("since", v), nil)
+	assertEqual(t, options.New([]byte("ab")).EncodeQuery("data", v), nil)
+	assertEqual(t, v.Encode(), "data=ab&since=2021-01-02T03%3A04%3A05Z")
+
+	since, err := options.FromQuery[time.Time](v, "since")
+	assertEqual(t, err, nil)
+	assertEqual(t, since, options.New(ts))
+
+	data, err := options.FromQuery[[]byte](v, "data")
+	assertEqual(t, err, nil)
+	assertDeepEqual(t, data, options.New([]byte("ab")))
+
+	invalid := time.Date(10000, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = options.New(invalid).EncodeQuery("until", v)
+	if err == nil {
+		t.Error("should fail")
+	}
+	assertEqual(t, v.Has("until"), false)
+}
+
+func TestFromQuery(t *testing.T) {
+	v, err := url.ParseQuery("name=alice&age=20&nickname=&age=30")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := options.FromQuery[string](v, "name")
+	assertEqual(t, err, nil)
+	assertEqual(t, name, options.New("alice"))
+
+	age, err := options.FromQuery[int](v, "age")
+	assertEqual(t, err, nil)
+	assertEqual(t, age, options.New(20))
+
+	nickname, err := options.FromQuery[string](v, "nickname")
+	assertEqual(t, err, nil)
+	assertEqual(t, nickname, options.New(""))
+
+	email, err := options.FromQuery[string](v, "email")
+	assertEqual(t, err, nil)
+	assertEqual(t, email, options.None[string]())
+
+	invalid, err := options.FromQuery[int](v, "name")
+	assertEqual(t, err.Error(), `FromQuery[int]: converting driver.Value type string ("alice") to a int: invalid syntax`)
+	assertEqual(t, invalid, options.None[int]())
+}
@@ -0,0 +1,68 @@
+package options
+
+import "fmt"
+
+// Result[T] represents either a value of type T or an error.
+//
+// Results that have a value are created by [Ok], and results that have an error are created by [Err].
+// The zero value of Result[T] is Ok with the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a new Result[T] with the given value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{value: value}
+}
+
+// Err returns a new Result[T] with the given error.
+// If the error is nil, Err panics.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic(fmt.Errorf("Err: nil error for Result[%T]", *new(T)))
+	}
+	return Result[T]{err: err}
+}
+
+// IsOk returns true if the result has a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Unwrap returns the value of the result.
+// If the result has an error, Unwrap panics with an error wrapping it.
+func (r Result[T]) Unwrap() T {
+	if r.err == nil {
+		return r.value
+	} else {
+		panic(fmt.Errorf("Result[%T].Unwrap: %w", r.value, r.err))
+	}
+}
+
+// UnwrapErr returns the error of the result.
+// If the result has a value, nil is returned.
+func (r Result[T]) UnwrapErr() error {
+	return r.err
+}
+
+// ToOption converts the result into an option.
+// If the result has an error, the error is dropped and None is returned.
+func (r Result[T]) ToOption() Option[T] {
+	if r.err == nil {
+		return New(r.value)
+	} else {
+		return None[T]()
+	}
+}
+
+// ToResult converts the option into a result.
+// If the option is None, a result with the given error is returned.
+// In that case, the error must not be nil.
+func (o Option[T]) ToResult(err error) Result[T] {
+	if o.present {
+		return Ok(o.value)
+	} else {
+		return Err[T](err)
+	}
+}
@@ -0,0 +1,61 @@
+package options_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestResult(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	ok := options.Ok(42)
+	assertEqual(t, ok.IsOk(), true)
+	assertEqual(t, ok.Unwrap(), 42)
+	assertEqual(t, ok.UnwrapErr(), nil)
+	assertEqual(t, ok.ToOption(), options.New(42))
+
+	err := options.Err[int](errNotFound)
+	assertEqual(t, err.IsOk(), false)
+	assertEqual(t, err.UnwrapErr(), errNotFound)
+	assertEqual(t, err.ToOption(), options.None[int]())
+
+	assertEqual(t, options.New(42).ToResult(errNotFound), ok)
+	assertEqual(t, options.None[int]().ToResult(errNotFound), err)
+
+	var zero options.Result[int]
+	assertEqual(t, zero.IsOk(), true)
+	assertEqual(t, zero.Unwrap(), 0)
+}
+
+func TestResult_UnwrapPanic(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("unexpected panic value: %#v", r)
+		}
+		assertEqual(t, err.Error(), "Result[int].Unwrap: not found")
+		assertEqual(t, errors.Is(err, errNotFound), true)
+	}()
+
+	options.Err[int](errNotFound).Unwrap()
+	t.Error("should panic")
+}
+
+func TestErr_Nil(t *testing.T) {
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("unexpected panic value: %#v", r)
+		}
+		assertEqual(t, err.Error(), "Err: nil error for Result[int]")
+	}()
+
+	options.Err[int](nil)
+	t.Error("should panic")
+}
@@ -0,0 +1,209 @@
+package options
+
+// Reduce folds the present values of the given options by the given function.
+// The first present value is used as the initial accumulator, and None elements are skipped.
+// If no option is present, including the case of an empty slice, None is returned.
+func Reduce[T any](opts []Option[T], f func(acc, v T) T) Option[T] {
+	var result Option[T]
+	for _, o := range opts {
+		if !o.present {
+			continue
+		}
+		if result.present {
+			result.value = f(result.value, o.value)
+		} else {
+			result = o
+		}
+	}
+	return result
+}
+
+// FirstPresent returns the first present option among the given options.
+// If no option is present, None is returned.
+func FirstPresent[T any](opts ...Option[T]) Option[T] {
+	for _, o := range opts {
+		if o.present {
+			return o
+		}
+	}
+	return None[T]()
+}
+
+// FindPresent returns the first present option among the given options whose value satisfies the given predicate.
+// None elements are skipped, and pred is not called after the first match.
+// If no option matches, None is returned.
+func FindPresent[T any](opts []Option[T], pred func(T) bool) Option[T] {
+	for _, o := range opts {
+		if o.present && pred(o.value) {
+			return o
+		}
+	}
+	return None[T]()
+}
+
+// Overlay returns the last present option among the given layers.
+// Later layers override earlier ones, e.g. Overlay(defaults, file, env, flags).
+// If no option is present, None is returned.
+//
+// This is the "last wins" counterpart of [FirstPresent].
+func Overlay[T any](layers ...Option[T]) Option[T] {
+	for i := len(layers) - 1; i >= 0; i-- {
+		if layers[i].present {
+			return layers[i]
+		}
+	}
+	return None[T]()
+}
+
+// Partition splits the given options into the present values and the number of None elements.
+// The present values are returned in the same order as opts.
+func Partition[T any](opts []Option[T]) (present []T, noneCount int) {
+	present = FilterPresent(opts)
+	return present, len(opts) - len(present)
+}
+
+// FilterPresent returns the present values of the given options in the same order as opts.
+// If no option is present, an empty non-nil slice is returned.
+func FilterPresent[T any](opts []Option[T]) []T {
+	present := make([]T, 0, CountPresent(opts))
+	for _, o := range opts {
+		if o.present {
+			present = append(present, o.value)
+		}
+	}
+	return present
+}
+
+// CountPresent returns the number of present options.
+func CountPresent[T any](opts []Option[T]) int {
+	count := 0
+	for _, o := range opts {
+		if o.present {
+			count++
+		}
+	}
+	return count
+}
+
+// IndexPresent returns the index of the first present option.
+// If no option is present, -1 is returned.
+func IndexPresent[T any](opts []Option[T]) int {
+	for i, o := range opts {
+		if o.present {
+			return i
+		}
+	}
+	return -1
+}
+
+// AllPresent returns true if all the given options are present.
+// If opts is empty, true is returned.
+func AllPresent[T any](opts []Option[T]) bool {
+	for _, o := range opts {
+		if !o.present {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyPresent returns true if at least one of the given options is present.
+// If opts is empty, false is returned.
+func AnyPresent[T any](opts []Option[T]) bool {
+	for _, o := range opts {
+		if o.present {
+			return true
+		}
+	}
+	return false
+}
+
+// AllMatch returns true if all the given options are present and their values satisfy the given predicate.
+// None elements are treated as failing, so AllMatch returns false if any of opts is None.
+// Use [FilterPresent] beforehand to ignore None elements.
+// If opts is empty, true is returned.
+func AllMatch[T any](opts []Option[T], pred func(T) bool) bool {
+	for _, o := range opts {
+		if !o.present || !pred(o.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Coalesce returns the value of the first present option among the given options.
+// If no option is present, the given default value is returned.
+//
+// This is the same as the COALESCE function of SQL.
+func Coalesce[T any](opts []Option[T], defaultValue T) T {
+	for _, o := range opts {
+		if o.present {
+			return o.value
+		}
+	}
+	return defaultValue
+}
+
+// Slice[T] is a slice of options with aggregate methods.
+//
+// Slice[T] and []Option[T] are convertible to each other, e.g. Slice[T](opts) and []Option[T](s).
+type Slice[T any] []Option[T]
+
+// Present returns the present values in the same order as s.
+// See [FilterPresent] for details.
+func (s Slice[T]) Present() []T {
+	return FilterPresent(s)
+}
+
+// CountNone returns the number of None elements.
+func (s Slice[T]) CountNone() int {
+	return len(s) - CountPresent(s)
+}
+
+// All returns true if all elements are present and their values satisfy the given predicate.
+// See [AllMatch] for details.
+func (s Slice[T]) All(pred func(T) bool) bool {
+	return AllMatch(s, pred)
+}
+
+// Map returns a new slice by applying the given function to the present values.
+// None elements are kept as None.
+// Use the [Map] function over the elements to change the type of the values.
+func (s Slice[T]) Map(f func(T) T) Slice[T] {
+	if s == nil {
+		return nil
+	}
+	result := make(Slice[T], len(s))
+	for i, o := range s {
+		result[i] = Map(o, f)
+	}
+	return result
+}
+
+// MapSlice returns a new slice of options by applying the given function to each element of in.
+// The order of the elements is preserved. If in is nil, nil is returned.
+func MapSlice[A any, B any](in []A, f func(A) Option[B]) []Option[B] {
+	if in == nil {
+		return nil
+	}
+	result := make([]Option[B], len(in))
+	for i, v := range in {
+		result[i] = f(v)
+	}
+	return result
+}
+
+// MapSliceCollect returns a new slice of the values made by applying the given function to each element of in.
+// If f returns None for any element, None is returned immediately without calling f for the rest.
+// The order of the elements is preserved. If in is empty, an empty non-nil slice is returned as a present option.
+func MapSliceCollect[A any, B any](in []A, f func(A) Option[B]) Option[[]B] {
+	result := make([]B, 0, len(in))
+	for _, v := range in {
+		o := f(v)
+		if !o.present {
+			return None[[]B]()
+		}
+		result = append(result, o.value)
+	}
+	return New(result)
+}
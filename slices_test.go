@@ -0,0 +1,233 @@
+package options_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+
+	opts := []options.Option[int]{
+		options.None[int](),
+		options.New(1),
+		options.None[int](),
+		options.New(2),
+		options.New(3),
+	}
+	assertEqual(t, options.Reduce(opts, sum), options.New(6))
+
+	var seeds []int
+	options.Reduce(opts, func(acc, v int) int {
+		seeds = append(seeds, acc)
+		return acc + v
+	})
+	assertDeepEqual(t, seeds, []int{1, 3})
+
+	assertEqual(t, options.Reduce([]options.Option[int]{options.None[int](), options.New(42)}, sum), options.New(42))
+	assertEqual(t, options.Reduce([]options.Option[int]{options.None[int](), options.None[int]()}, sum), options.None[int]())
+	assertEqual(t, options.Reduce([]options.Option[int]{}, sum), options.None[int]())
+	assertEqual(t, options.Reduce(nil, sum), options.None[int]())
+}
+
+func TestFirstPresent(t *testing.T) {
+	assertEqual(t, options.FirstPresent(options.None[int](), options.New(1), options.New(2)), options.New(1))
+	assertEqual(t, options.FirstPresent(options.New(0), options.New(1)), options.New(0))
+	assertEqual(t, options.FirstPresent(options.None[int](), options.None[int]()), options.None[int]())
+	assertEqual(t, options.FirstPresent[int](), options.None[int]())
+}
+
+func TestFindPresent(t *testing.T) {
+	var checked []int
+	isEven := func(v int) bool {
+		checked = append(checked, v)
+		return v%2 == 0
+	}
+
+	opts := []options.Option[int]{
+		options.None[int](),
+		options.New(1),
+		options.New(4),
+		options.None[int](),
+		options.New(6),
+	}
+	assertEqual(t, options.FindPresent(opts, isEven), options.New(4))
+	assertDeepEqual(t, checked, []int{1, 4})
+
+	isNegative := func(v int) bool { return v < 0 }
+	assertEqual(t, options.FindPresent(opts, isNegative), options.None[int]())
+	assertEqual(t, options.FindPresent(nil, isNegative), options.None[int]())
+}
+
+func TestOverlay(t *testing.T) {
+	defaults := options.New(30)
+	file := options.None[int]()
+	env := options.New(60)
+	flags := options.None[int]()
+
+	assertEqual(t, options.Overlay(defaults, file, env, flags), options.New(60))
+	assertEqual(t, options.Overlay(defaults, file), options.New(30))
+	assertEqual(t, options.Overlay(defaults, options.New(0)), options.New(0))
+	assertEqual(t, options.Overlay(file, flags), options.None[int]())
+	assertEqual(t, options.Overlay[int](), options.None[int]())
+}
+
+func TestPartition(t *testing.T) {
+	present, noneCount := options.Partition([]options.Option[string]{
+		options.New("foo"),
+		options.None[string](),
+		options.New(""),
+		options.None[string](),
+		options.New("bar"),
+	})
+	assertDeepEqual(t, present, []string{"foo", "", "bar"})
+	assertEqual(t, noneCount, 2)
+
+	present, noneCount = options.Partition([]options.Option[string]{options.None[string]()})
+	assertDeepEqual(t, present, []string{})
+	assertEqual(t, noneCount, 1)
+
+	present, noneCount = options.Partition[string](nil)
+	assertDeepEqual(t, present, []string{})
+	assertEqual(t, noneCount, 0)
+}
+
+func TestFilterPresent(t *testing.T) {
+	opts := []options.Option[int]{
+		options.New(3),
+		options.None[int](),
+		options.New(1),
+		options.New(2),
+	}
+	assertDeepEqual(t, options.FilterPresent(opts), []int{3, 1, 2})
+
+	empty := options.FilterPresent([]options.Option[int]{options.None[int]()})
+	if empty == nil {
+		t.Error("should return a non-nil slice")
+	}
+	assertEqual(t, len(empty), 0)
+
+	assertDeepEqual(t, options.FilterPresent[int](nil), []int{})
+}
+
+func TestCountPresent(t *testing.T) {
+	opts := []options.Option[int]{
+		options.New(3),
+		options.None[int](),
+		options.New(0),
+	}
+	assertEqual(t, options.CountPresent(opts), 2)
+	assertEqual(t, options.CountPresent([]options.Option[int]{options.None[int]()}), 0)
+	assertEqual(t, options.CountPresent[int](nil), 0)
+}
+
+func TestIndexPresent(t *testing.T) {
+	opts := []options.Option[int]{
+		options.None[int](),
+		options.New(0),
+		options.New(3),
+	}
+	assertEqual(t, options.IndexPresent(opts), 1)
+	assertEqual(t, options.IndexPresent([]options.Option[int]{options.New(3)}), 0)
+	assertEqual(t, options.IndexPresent([]options.Option[int]{options.None[int]()}), -1)
+	assertEqual(t, options.IndexPresent[int](nil), -1)
+}
+
+func TestAllPresentAnyPresent(t *testing.T) {
+	all := []options.Option[int]{options.New(1), options.New(0)}
+	some := []options.Option[int]{options.None[int](), options.New(1)}
+	none := []options.Option[int]{options.None[int](), options.None[int]()}
+
+	assertEqual(t, options.AllPresent(all), true)
+	assertEqual(t, options.AllPresent(some), false)
+	assertEqual(t, options.AllPresent(none), false)
+	assertEqual(t, options.AllPresent([]options.Option[int]{}), true)
+
+	assertEqual(t, options.AnyPresent(all), true)
+	assertEqual(t, options.AnyPresent(some), true)
+	assertEqual(t, options.AnyPresent(none), false)
+	assertEqual(t, options.AnyPresent([]options.Option[int]{}), false)
+}
+
+func TestAllMatch(t *testing.T) {
+	isPositive := func(v int) bool { return v > 0 }
+
+	assertEqual(t, options.AllMatch([]options.Option[int]{options.New(1), options.New(2)}, isPositive), true)
+	assertEqual(t, options.AllMatch([]options.Option[int]{options.New(1), options.New(-2)}, isPositive), false)
+	assertEqual(t, options.AllMatch([]options.Option[int]{options.New(1), options.None[int]()}, isPositive), false)
+	assertEqual(t, options.AllMatch(nil, isPositive), true)
+}
+
+func TestCoalesce(t *testing.T) {
+	assertEqual(t, options.Coalesce([]options.Option[string]{
+		options.None[string](),
+		options.New(""),
+		options.New("foo"),
+	}, "default"), "")
+	assertEqual(t, options.Coalesce([]options.Option[string]{
+		options.None[string](),
+		options.None[string](),
+	}, "default"), "default")
+	assertEqual(t, options.Coalesce(nil, "default"), "default")
+}
+
+func TestSlice(t *testing.T) {
+	s := options.Slice[int]{
+		options.New(1),
+		options.None[int](),
+		options.New(3),
+	}
+	assertDeepEqual(t, s.Present(), []int{1, 3})
+	assertEqual(t, s.CountNone(), 1)
+
+	isPositive := func(v int) bool { return v > 0 }
+	assertEqual(t, s.All(isPositive), false)
+	assertEqual(t, options.Slice[int]{options.New(1), options.New(3)}.All(isPositive), true)
+	assertEqual(t, options.Slice[int]{options.New(1), options.New(-3)}.All(isPositive), false)
+	assertEqual(t, options.Slice[int]{}.All(isPositive), true)
+
+	doubled := s.Map(func(v int) int { return v * 2 })
+	assertDeepEqual(t, doubled, options.Slice[int]{
+		options.New(2),
+		options.None[int](),
+		options.New(6),
+	})
+	assertEqual(t, s[0], options.New(1))
+	assertDeepEqual(t, options.Slice[int](nil).Map(func(v int) int { return v }), nil)
+
+	// Slice[T] is convertible from and to []Option[T].
+	opts := []options.Option[int](s)
+	assertEqual(t, options.Slice[int](opts).CountNone(), 1)
+}
+
+func TestMapSlice(t *testing.T) {
+	parse := func(s string) options.Option[int] {
+		return options.FromError(strconv.Atoi(s))
+	}
+
+	assertDeepEqual(t, options.MapSlice([]string{"1", "foo", "3"}, parse), []options.Option[int]{
+		options.New(1),
+		options.None[int](),
+		options.New(3),
+	})
+	assertDeepEqual(t, options.MapSlice([]string{}, parse), []options.Option[int]{})
+	assertDeepEqual(t, options.MapSlice(nil, parse), nil)
+}
+
+func TestMapSliceCollect(t *testing.T) {
+	var called []string
+	parse := func(s string) options.Option[int] {
+		called = append(called, s)
+		return options.FromError(strconv.Atoi(s))
+	}
+
+	assertDeepEqual(t, options.MapSliceCollect([]string{"1", "2", "3"}, parse), options.New([]int{1, 2, 3}))
+
+	called = nil
+	assertDeepEqual(t, options.MapSliceCollect([]string{"1", "foo", "3"}, parse), options.None[[]int]())
+	assertDeepEqual(t, called, []string{"1", "foo"})
+
+	assertDeepEqual(t, options.MapSliceCollect(nil, parse), options.New([]int{}))
+}
@@ -42,6 +42,7 @@ import (
 	"bytes"
 	"database/sql"
 	"database/sql/driver"
+	"encoding"
 	"errors"
 	"fmt"
 	"reflect"
@@ -77,6 +78,11 @@ func convertAssign(dest, src any) error {
 			}
 			*d = append((*d)[:0], s...)
 			return nil
+		case *time.Time:
+			if d == nil {
+				return errNilPtr
+			}
+			return parseTime(d, s)
 		}
 	case []byte:
 		switch d := dest.(type) {
@@ -104,6 +110,11 @@ func convertAssign(dest, src any) error {
 			}
 			*d = s
 			return nil
+		case *time.Time:
+			if d == nil {
+				return errNilPtr
+			}
+			return parseTime(d, string(s))
 		}
 	case time.Time:
 		switch d := dest.(type) {
@@ -285,9 +296,45 @@ func convertAssign(dest, src any) error {
 		}
 	}
 
+	// This is not in the original database/sql.
+	// Types such as *big.Int can be scanned from their text representation.
+	if u, ok := dest.(encoding.TextUnmarshaler); ok {
+		switch src.(type) {
+		case string, []byte, int64, float64:
+			s := asString(src)
+			if err := u.UnmarshalText([]byte(s)); err != nil {
+				return fmt.Errorf("converting driver.Value type %T (%q) to a %T: %w", src, s, dest, err)
+			}
+			return nil
+		}
+	}
+
 	return fmt.Errorf("unsupported Scan, storing driver.Value type %T into type %T", src, dest)
 }
 
+// timeFormats are the formats of time.Time stored as string, tried in order by parseTime.
+// This is not in the original database/sql.
+var timeFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// parseTime parses s into *d by timeFormats.
+// Times without time zone are parsed as UTC.
+// This is not in the original database/sql.
+func parseTime(d *time.Time, s string) error {
+	for _, format := range timeFormats {
+		if t, err := time.Parse(format, s); err == nil {
+			*d = t
+			return nil
+		}
+	}
+	return fmt.Errorf("converting driver.Value type string (%q) to a time.Time: unsupported format", s)
+}
+
 func strconvErr(err error) error {
 	if ne, ok := err.(*strconv.NumError); ok {
 		return ne.Err
@@ -121,6 +121,14 @@ func conversionTests() []conversionTest {
 		{s: time.Unix(1, 2).UTC(), d: &scanbytes, wantbytes: []byte("1970-01-01T00:00:01.000000002Z")},
 		{s: time.Unix(1, 2).UTC(), d: &scaniface, wantiface: time.Unix(1, 2).UTC()},
 
+		// To time.Time (not in the original database/sql)
+		{s: "1970-01-01T00:00:01Z", d: &scantime, wanttime: time.Unix(1, 0)},
+		{s: "1970-01-01T09:00:01.5+09:00", d: &scantime, wanttime: time.Unix(1, 5e8)},
+		{s: []byte("1970-01-01 00:00:01"), d: &scantime, wanttime: time.Unix(1, 0)},
+		{s: "1970-01-01 09:00:01+09:00", d: &scantime, wanttime: time.Unix(1, 0)},
+		{s: "1970-01-02", d: &scantime, wanttime: time.Unix(86400, 0)},
+		{s: "yesterday", d: &scantime, wanterr: `converting driver.Value type string ("yesterday") to a time.Time: unsupported format`},
+
 		// To []byte
 		{s: nil, d: &scanbytes, wantbytes: nil},
 		{s: "string", d: &scanbytes, wantbytes: []byte("string")},
@@ -0,0 +1,21 @@
+//go:build go1.22
+
+package options
+
+import "database/sql"
+
+// FromSQLNull creates Option[T] from [sql.Null].
+// If n.Valid is false, None is returned.
+// Otherwise, a new Option[T] with n.V is returned.
+func FromSQLNull[T any](n sql.Null[T]) Option[T] {
+	return FromTuple(n.V, n.Valid)
+}
+
+// ToSQLNull converts the option into [sql.Null].
+// If the option is None, the returned value has Valid == false.
+func (o Option[T]) ToSQLNull() sql.Null[T] {
+	return sql.Null[T]{
+		V:     o.value,
+		Valid: o.present,
+	}
+}
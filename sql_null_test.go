@@ -0,0 +1,26 @@
+//go:build go1.22
+
+package options_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func TestFromSQLNull(t *testing.T) {
+	assertEqual(t, options.FromSQLNull(sql.Null[int]{V: 42, Valid: true}), options.New(42))
+	assertEqual(t, options.FromSQLNull(sql.Null[int]{V: 0, Valid: true}), options.New(0))
+	assertEqual(t, options.FromSQLNull(sql.Null[int]{}), options.None[int]())
+}
+
+func TestToSQLNull(t *testing.T) {
+	assertEqual(t, options.New("hello").ToSQLNull(), sql.Null[string]{V: "hello", Valid: true})
+	assertEqual(t, options.New("").ToSQLNull(), sql.Null[string]{V: "", Valid: true})
+	assertEqual(t, options.None[string]().ToSQLNull(), sql.Null[string]{})
+
+	for _, opt := range []options.Option[string]{options.New("hello"), options.None[string]()} {
+		assertEqual(t, options.FromSQLNull(opt.ToSQLNull()), opt)
+	}
+}
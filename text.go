@@ -0,0 +1,79 @@
+package options
+
+import (
+	"encoding"
+	"fmt"
+	"strings"
+)
+
+// NoneText is the text representation of None used by [FormatOption] and [ParseOption].
+const NoneText = "∅"
+
+// FormatOption returns the text representation of the option, which can be parsed back by [ParseOption].
+//
+// If the option is None, [NoneText] is returned.
+// If the option is present, the value is formatted by its MarshalText method if T implements
+// [encoding.TextMarshaler], as is if T is []byte, or by [fmt.Sprint] otherwise.
+// If the formatted value is equal to NoneText or begins with a backslash, a backslash is prepended to it,
+// so that the result is distinguished from None.
+//
+// This is a function rather than a MarshalText method, because Option[T] implementing [encoding.TextMarshaler]
+// would change how encoding/xml and encoding/json handle it, e.g. as XML attributes and JSON object keys.
+func FormatOption[T any](o Option[T]) (string, error) {
+	if !o.present {
+		return NoneText, nil
+	}
+
+	text, err := formatText(o.value)
+	if err != nil {
+		return "", fmt.Errorf("FormatOption[%T]: %w", o.value, err)
+	}
+
+	if text == NoneText || strings.HasPrefix(text, `\`) {
+		text = `\` + text
+	}
+	return text, nil
+}
+
+// ParseOption parses the text representation of an option made by [FormatOption].
+// If s is [NoneText], None and a nil error are returned without calling parse.
+// Otherwise, the leading backslash for escaping is removed if any, and the rest is parsed by parse.
+// If parse returns an error, None and the error are returned.
+func ParseOption[T any](s string, parse func(string) (T, error)) (Option[T], error) {
+	if s == NoneText {
+		return None[T](), nil
+	}
+	v, err := parse(strings.TrimPrefix(s, `\`))
+	if err != nil {
+		return None[T](), err
+	}
+	return New(v), nil
+}
+
+// formatText returns the text representation of v.
+// It is the result of MarshalText if v implements [encoding.TextMarshaler],
+// the bytes as is if v is []byte, or the result of [fmt.Sprint] otherwise.
+func formatText(v any) (string, error) {
+	switch v := v.(type) {
+	case encoding.TextMarshaler:
+		b, err := v.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	case []byte:
+		return string(v), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+// parseText parses s into dest, which must be a pointer.
+// It calls UnmarshalText if dest implements [encoding.TextUnmarshaler],
+// or converts s in the same way as [Option.Scan] does for a string otherwise.
+func parseText(dest any, s string) error {
+	if u, ok := dest.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+	return convertAssign(dest, s)
+}
@@ -0,0 +1,62 @@
+package options_test
+
+import (
+	"encoding"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cybozu-go/options"
+)
+
+func formatOption[T any](t *testing.T, o options.Option[T]) string {
+	t.Helper()
+	s, err := options.FormatOption(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestFormatOption(t *testing.T) {
+	assertEqual(t, formatOption(t, options.New(42)), "42")
+	assertEqual(t, formatOption(t, options.None[int]()), "∅")
+	assertEqual(t, formatOption(t, options.New("")), "")
+	assertEqual(t, formatOption(t, options.New("∅")), `\∅`)
+	assertEqual(t, formatOption(t, options.New(`\foo`)), `\\foo`)
+	assertEqual(t, formatOption(t, options.New("∅∅")), "∅∅")
+
+	ts := time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)
+	assertEqual(t, formatOption(t, options.New(ts)), "2021-02-03T04:05:06Z")
+
+	_, ok := any(options.New(42)).(encoding.TextMarshaler)
+	assertEqual(t, ok, false)
+}
+
+func TestParseOption(t *testing.T) {
+	identity := func(s string) (string, error) { return s, nil }
+
+	for _, opt := range []options.Option[string]{
+		options.New("foo"),
+		options.New(""),
+		options.New("∅"),
+		options.New(`\`),
+		options.New(`\∅`),
+		options.New(`\\foo`),
+		options.None[string](),
+	} {
+		parsed, err := options.ParseOption(formatOption(t, opt), identity)
+		assertEqual(t, err, nil)
+		assertEqual(t, parsed, opt)
+	}
+
+	opt1, err := options.ParseOption("42", strconv.Atoi)
+	assertEqual(t, err, nil)
+	assertEqual(t, opt1, options.New(42))
+
+	opt2, err := options.ParseOption("foo", strconv.Atoi)
+	if err == nil {
+		t.Error("should fail")
+	}
+	assertEqual(t, opt2, options.None[int]())
+}
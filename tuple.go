@@ -0,0 +1,59 @@
+package options
+
+// Tuple2 is a tuple of two values.
+type Tuple2[A any, B any] struct {
+	V1 A
+	V2 B
+}
+
+// Tuple3 is a tuple of three values.
+type Tuple3[A any, B any, C any] struct {
+	V1 A
+	V2 B
+	V3 C
+}
+
+// Zip returns a new option of a tuple of the values of the given options.
+// If any of the options is None, None is returned.
+func Zip[A any, B any](a Option[A], b Option[B]) Option[Tuple2[A, B]] {
+	if a.present && b.present {
+		return New(Tuple2[A, B]{V1: a.value, V2: b.value})
+	} else {
+		return None[Tuple2[A, B]]()
+	}
+}
+
+// Zip3 returns a new option of a tuple of the values of the given options.
+// If any of the options is None, None is returned.
+func Zip3[A any, B any, C any](a Option[A], b Option[B], c Option[C]) Option[Tuple3[A, B, C]] {
+	if a.present && b.present && c.present {
+		return New(Tuple3[A, B, C]{V1: a.value, V2: b.value, V3: c.value})
+	} else {
+		return None[Tuple3[A, B, C]]()
+	}
+}
+
+// ZipWith returns a new option by applying the given function to the values of the given options.
+// If either of the options is None, None is returned without calling f.
+func ZipWith[A any, B any, C any](a Option[A], b Option[B], f func(A, B) C) Option[C] {
+	if a.present && b.present {
+		return New(f(a.value, b.value))
+	} else {
+		return None[C]()
+	}
+}
+
+// Transpose converts an option of a tuple of (T, error) into a tuple of (Option[T], error).
+//
+//	None                       => None, nil
+//	New(Tuple2{V1: v, V2: nil}) => New(v), nil
+//	New(Tuple2{V1: v, V2: err}) => None, err
+func Transpose[T any](o Option[Tuple2[T, error]]) (Option[T], error) {
+	if !o.present {
+		return None[T](), nil
+	}
+	if o.value.V2 != nil {
+		return None[T](), o.value.V2
+	}
+	return New(o.value.V1), nil
+}
@@ -0,0 +1,74 @@
+package options_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/cybozu-go/options"
+)
+
+func ExampleZip3() {
+	host := options.New("example.com")
+	port := options.New(8080)
+	path := options.New("/index.html")
+
+	if t := options.Zip3(host, port, path); t.IsPresent() {
+		v := t.Unwrap()
+		fmt.Printf("http://%s:%d%s\n", v.V1, v.V2, v.V3)
+	}
+
+	// Output:
+	// http://example.com:8080/index.html
+}
+
+func TestZip(t *testing.T) {
+	assertEqual(t, options.Zip(options.New(1), options.New("a")), options.New(options.Tuple2[int, string]{V1: 1, V2: "a"}))
+	assertEqual(t, options.Zip(options.None[int](), options.New("a")), options.None[options.Tuple2[int, string]]())
+	assertEqual(t, options.Zip(options.New(1), options.None[string]()), options.None[options.Tuple2[int, string]]())
+	assertEqual(t, options.Zip(options.None[int](), options.None[string]()), options.None[options.Tuple2[int, string]]())
+}
+
+func TestZip3(t *testing.T) {
+	type tuple = options.Tuple3[int, string, bool]
+
+	assertEqual(t, options.Zip3(options.New(1), options.New("a"), options.New(true)), options.New(tuple{V1: 1, V2: "a", V3: true}))
+	assertEqual(t, options.Zip3(options.None[int](), options.New("a"), options.New(true)), options.None[tuple]())
+	assertEqual(t, options.Zip3(options.New(1), options.None[string](), options.New(true)), options.None[tuple]())
+	assertEqual(t, options.Zip3(options.New(1), options.New("a"), options.None[bool]()), options.None[tuple]())
+	assertEqual(t, options.Zip3(options.None[int](), options.None[string](), options.None[bool]()), options.None[tuple]())
+}
+
+func TestZipWith(t *testing.T) {
+	called := false
+	area := func(w, h int) int {
+		called = true
+		return w * h
+	}
+
+	assertEqual(t, options.ZipWith(options.New(3), options.New(4), area), options.New(12))
+	assertEqual(t, called, true)
+
+	called = false
+	assertEqual(t, options.ZipWith(options.None[int](), options.New(4), area), options.None[int]())
+	assertEqual(t, options.ZipWith(options.New(3), options.None[int](), area), options.None[int]())
+	assertEqual(t, options.ZipWith(options.None[int](), options.None[int](), area), options.None[int]())
+	assertEqual(t, called, false)
+}
+
+func TestTranspose(t *testing.T) {
+	type result = options.Tuple2[int, error]
+	errTest := errors.New("test")
+
+	opt1, err1 := options.Transpose(options.None[result]())
+	assertEqual(t, opt1, options.None[int]())
+	assertEqual(t, err1, nil)
+
+	opt2, err2 := options.Transpose(options.New(result{V1: 42, V2: nil}))
+	assertEqual(t, opt2, options.New(42))
+	assertEqual(t, err2, nil)
+
+	opt3, err3 := options.Transpose(options.New(result{V1: 42, V2: errTest}))
+	assertEqual(t, opt3, options.None[int]())
+	assertEqual(t, err3, errTest)
+}